@@ -0,0 +1,100 @@
+package torrentname
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ParseBatch parses names concurrently with the default Parser.
+// Results are in the same order as names.
+func ParseBatch(names []string, workers int) []*TorrentInfo {
+	return defaultParser.ParseBatch(names, workers)
+}
+
+// ParseBatchContext parses names concurrently with the default Parser,
+// stopping early when ctx is canceled. See Parser.ParseBatchContext.
+func ParseBatchContext(ctx context.Context, names []string, workers int) ([]*TorrentInfo, error) {
+	return defaultParser.ParseBatchContext(ctx, names, workers)
+}
+
+// ParseBatch parses names concurrently using up to workers goroutines
+// (GOMAXPROCS when workers <= 0). Results are in the same order as names.
+func (p *Parser) ParseBatch(names []string, workers int) []*TorrentInfo {
+	results, _ := p.ParseBatchContext(context.Background(), names, workers)
+	return results
+}
+
+// ParseBatchContext parses names concurrently using up to workers goroutines
+// (GOMAXPROCS when workers <= 0). Results are in the same order as names.
+// When ctx is canceled no further names are dispatched; the names already
+// parsed are returned (the rest are nil) along with ctx.Err(). All worker
+// goroutines have exited by the time it returns.
+func (p *Parser) ParseBatchContext(ctx context.Context, names []string, workers int) ([]*TorrentInfo, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(names) {
+		workers = len(names)
+	}
+
+	results := make([]*TorrentInfo, len(names))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = p.Parse(names[i])
+			}
+		}()
+	}
+
+	// Dispatch until every name is queued or the context is canceled
+	var err error
+dispatch:
+	for i := range names {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break dispatch
+		case jobs <- i:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results, err
+}
+
+// ParseStream parses newline-delimited names from r with the default Parser.
+// See Parser.ParseStream.
+func ParseStream(r io.Reader, fn func(*TorrentInfo)) error {
+	return defaultParser.ParseStream(r, fn)
+}
+
+// ParseStream reads names from r one line at a time and calls fn with each
+// parse, in input order. Lines are trimmed of surrounding white space and
+// blank lines are skipped. Only the current line is held in memory, so
+// arbitrarily long lists can be piped through. The returned error is the
+// first error from r other than io.EOF, or bufio.ErrTooLong for a line
+// longer than bufio.MaxScanTokenSize.
+func (p *Parser) ParseStream(r io.Reader, fn func(*TorrentInfo)) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" {
+			continue
+		}
+		fn(p.Parse(name))
+	}
+	return scanner.Err()
+}
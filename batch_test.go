@@ -0,0 +1,121 @@
+package torrentname
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseBatch(t *testing.T) {
+	names := []string{
+		"The.Matrix.1999.1080p.BluRay.x264-SPARKS",
+		"Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS",
+		"Parasite.2019.KOREAN.1080p.BluRay.x264.DTS-FGT",
+		"",
+	}
+
+	for _, workers := range []int{0, 1, 3, 10} {
+		results := ParseBatch(names, workers)
+		if len(results) != len(names) {
+			t.Fatalf("workers=%d: got %d results, want %d", workers, len(results), len(names))
+		}
+		for i, name := range names {
+			compareTorrentInfo(t, results[i], Parse(name))
+		}
+	}
+}
+
+func TestParseBatchContextCompleted(t *testing.T) {
+	names := []string{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", "Some Movie"}
+
+	results, err := ParseBatchContext(context.Background(), names, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, name := range names {
+		compareTorrentInfo(t, results[i], Parse(name))
+	}
+}
+
+func TestParseBatchContextCanceled(t *testing.T) {
+	names := make([]string, 1000)
+	for i := range names {
+		names[i] = "The.Matrix.1999.1080p.BluRay.x264-SPARKS"
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	before := runtime.NumGoroutine()
+	results, err := ParseBatchContext(ctx, names, 4)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err: got %v, want %v", err, context.Canceled)
+	}
+	if len(results) != len(names) {
+		t.Fatalf("got %d results, want %d", len(results), len(names))
+	}
+	for i, result := range results {
+		if result != nil {
+			t.Errorf("results[%d] parsed after cancellation", i)
+		}
+	}
+
+	// Workers must all have exited
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("goroutines: %d before, %d after", before, after)
+	}
+}
+
+func TestParseStream(t *testing.T) {
+	input := "The.Matrix.1999.1080p.BluRay.x264-SPARKS\n\n  Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS  \r\n\t\nParasite.2019.KOREAN.1080p.BluRay.x264.DTS-FGT"
+	want := []string{
+		"The.Matrix.1999.1080p.BluRay.x264-SPARKS",
+		"Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS",
+		"Parasite.2019.KOREAN.1080p.BluRay.x264.DTS-FGT",
+	}
+
+	var got []*TorrentInfo
+	if err := ParseStream(strings.NewReader(input), func(info *TorrentInfo) {
+		got = append(got, info)
+	}); err != nil {
+		t.Fatalf("ParseStream: %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d results, want %d", len(got), len(want))
+	}
+	for i, name := range want {
+		compareTorrentInfo(t, got[i], Parse(name))
+	}
+}
+
+func TestParseStreamReaderError(t *testing.T) {
+	readErr := errors.New("disk on fire")
+	r := io.MultiReader(strings.NewReader("The.Matrix.1999.1080p.BluRay.x264-SPARKS\n"), &errReader{readErr})
+
+	calls := 0
+	err := ParseStream(r, func(*TorrentInfo) { calls++ })
+	if !errors.Is(err, readErr) {
+		t.Errorf("err = %v, want %v", err, readErr)
+	}
+	if calls != 1 {
+		t.Errorf("got %d callbacks, want 1", calls)
+	}
+}
+
+// errReader fails every read with err
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
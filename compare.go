@@ -0,0 +1,104 @@
+package torrentname
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SameContent reports whether info and other describe the same content,
+// ignoring quality: titles must be equal after NormalizeTitle, and Country,
+// Year, Season and Episode must match wherever both sides have a value (an
+// empty or 0 value on either side acts as a wildcard).
+func (info *TorrentInfo) SameContent(other *TorrentInfo) bool {
+	return info.SameContentTolerant(other, 0)
+}
+
+// SameContentTolerant is SameContent with Years allowed to differ by up to
+// years, for sources that date a film by production rather than release year
+// (2019 against 2020). A tolerance also matches remakes and sequels released a
+// year apart under the same title, so keep it small.
+func (info *TorrentInfo) SameContentTolerant(other *TorrentInfo, years int) bool {
+	if info == nil || other == nil {
+		return false
+	}
+
+	title := NormalizeTitle(info.Title)
+	if title == "" || title != NormalizeTitle(other.Title) {
+		return false
+	}
+
+	if info.Country != "" && other.Country != "" && info.Country != other.Country {
+		return false
+	}
+
+	return wildcardNear(info.Year, other.Year, years) &&
+		wildcardEqual(info.Season, other.Season) &&
+		wildcardEqual(info.Episode, other.Episode)
+}
+
+// wildcardEqual compares two numeric fields, treating 0 as unknown
+func wildcardEqual(a, b int) bool {
+	return a == 0 || b == 0 || a == b
+}
+
+// wildcardNear is wildcardEqual allowing a and b to differ by up to tolerance
+func wildcardNear(a, b, tolerance int) bool {
+	return a == 0 || b == 0 || a-b <= tolerance && b-a <= tolerance
+}
+
+// MatchKey returns a grouping key for collapsing releases of the same content:
+// the normalized title, Year, Season and Episode joined by "|", with empty
+// fields where a value is absent ("matrix|1999||", "breaking bad||1|1"). The
+// title includes any Country ("office us||1|1"), so remakes don't collide. A
+// season is present whenever one was named, so S00 specials and season packs
+// never share a key with a movie of the same title and year.
+func (info *TorrentInfo) MatchKey() string {
+	fields := []string{NormalizeTitle(strings.TrimSpace(info.Title + " " + info.Country)), "", "", ""}
+	if info.Year != 0 {
+		fields[1] = strconv.Itoa(info.Year)
+	}
+	if info.HasSeason || info.Season != 0 {
+		fields[2] = strconv.Itoa(info.Season)
+	}
+	if info.Episode != 0 {
+		fields[3] = strconv.Itoa(info.Episode)
+	}
+	return strings.Join(fields, "|")
+}
+
+// QualityRanks ranks the normalized Resolution, Source and Codec values.
+// Higher ranks are better; values missing from a table rank 0.
+type QualityRanks struct {
+	Resolution map[string]int
+	Source     map[string]int
+	Codec      map[string]int
+}
+
+// DefaultQualityRanks orders resolutions 4320p > 2160p > 1440p > 1080p > 720p > 480p > 360p,
+// sources REMUX > BluRay > WEB-DL > WEBRip/WEB/BDRIP > BRRIP > HDTV > DVD/PDTV/SDTV/DSR > TC > CAM,
+// and codecs by compression efficiency H265 > H264 > MPEG4/MPEG2.
+var DefaultQualityRanks = QualityRanks{
+	Resolution: map[string]int{
+		"4320p": 7, "2160p": 6, "1440p": 5, "1080p": 4, "720p": 3, "480p": 2, "360p": 1,
+	},
+	Source: map[string]int{
+		"REMUX": 9, "BluRay": 8, "WEB-DL": 7, "WEBRip": 6, "WEB": 6, "BDRIP": 6,
+		"BRRIP": 5, "HDTV": 4, "DVD": 3, "PDTV": 3, "SDTV": 3, "DSR": 3, "TC": 2, "CAM": 1,
+	},
+	Codec: map[string]int{
+		"H265": 3, "H264": 2, "MPEG4": 1, "MPEG2": 1,
+	},
+}
+
+// QualityScore ranks the release quality using DefaultQualityRanks so two
+// releases of the same content can be compared; higher is better.
+func (info *TorrentInfo) QualityScore() int {
+	return info.QualityScoreWith(DefaultQualityRanks)
+}
+
+// QualityScoreWith ranks the release quality using ranks. Resolution dominates,
+// then source, then codec: the score is resolution*100 + source*10 + codec, so
+// source and codec ranks should stay below 10.
+func (info *TorrentInfo) QualityScoreWith(ranks QualityRanks) int {
+	return ranks.Resolution[info.Resolution]*100 + ranks.Source[info.Source]*10 + ranks.Codec[info.Codec]
+}
@@ -0,0 +1,127 @@
+package torrentname
+
+import "testing"
+
+func TestSameContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected bool
+	}{
+		{"same movie different quality", "The.Matrix.1999.1080p.BluRay.x264-SPARKS", "The.Matrix.1999.2160p.WEB-DL.x265-GROUP", true},
+		{"missing year is a wildcard", "The.Matrix.1999.1080p.BluRay.x264-SPARKS", "The Matrix 720p HDTV", true},
+		{"different year", "The.Matrix.1999.1080p.BluRay.x264-SPARKS", "The.Matrix.2021.1080p.BluRay.x264-SPARKS", false},
+		{"different title", "The.Matrix.1999.1080p.BluRay.x264-SPARKS", "The.Matrix.Reloaded.2003.1080p.BluRay.x264-SPARKS", false},
+		{"same episode", "Breaking.Bad.S01E01.720p.HDTV.x264-CTU", "Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS", true},
+		{"different episode", "Breaking.Bad.S01E01.720p.HDTV.x264-CTU", "Breaking.Bad.S01E02.720p.HDTV.x264-CTU", false},
+		{"season pack matches its episode", "Breaking.Bad.S01.1080p.BluRay.x264-ROVERS", "Breaking.Bad.S01E02.720p.HDTV.x264-CTU", true},
+		{"different season", "Breaking.Bad.S01E01.720p.HDTV.x264-CTU", "Breaking.Bad.S02E01.720p.HDTV.x264-CTU", false},
+		{"different country", "The.Office.US.S01E01.720p.HDTV.x264-GROUP", "The.Office.UK.S01E01.720p.HDTV.x264-GROUP", false},
+		{"missing country is a wildcard", "The.Office.US.S01E01.720p.HDTV.x264-GROUP", "The.Office.S01E01.1080p.WEB-DL-GROUP", true},
+		{"empty titles", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := Parse(tt.a), Parse(tt.b)
+			if result := a.SameContent(b); result != tt.expected {
+				t.Errorf("SameContent(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+			if result := b.SameContent(a); result != tt.expected {
+				t.Errorf("SameContent(%q, %q) = %v, want %v", tt.b, tt.a, result, tt.expected)
+			}
+		})
+	}
+
+	if Parse("The Matrix").SameContent(nil) {
+		t.Error("SameContent(nil) = true, want false")
+	}
+}
+
+func TestSameContentTolerant(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      string
+		tolerance int
+		expected  bool
+	}{
+		{"year off by one", "Parasite.2019.1080p.BluRay.x264-GROUP", "Parasite.2020.2160p.WEB-DL.x265-GROUP", 1, true},
+		{"year off by one without tolerance", "Parasite.2019.1080p.BluRay.x264-GROUP", "Parasite.2020.2160p.WEB-DL.x265-GROUP", 0, false},
+		{"year off by two", "Parasite.2019.1080p.BluRay.x264-GROUP", "Parasite.2021.1080p.BluRay.x264-GROUP", 1, false},
+		{"missing year is a wildcard", "Parasite.2019.1080p.BluRay.x264-GROUP", "Parasite 720p HDTV", 1, true},
+		{"different title", "Parasite.2019.1080p.BluRay.x264-GROUP", "Joker.2019.1080p.BluRay.x264-GROUP", 1, false},
+		{"different episode", "Breaking.Bad.S01E01.720p.HDTV.x264-CTU", "Breaking.Bad.S01E02.720p.HDTV.x264-CTU", 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := Parse(tt.a), Parse(tt.b)
+			if result := a.SameContentTolerant(b, tt.tolerance); result != tt.expected {
+				t.Errorf("SameContentTolerant(%q, %q, %d) = %v, want %v", tt.a, tt.b, tt.tolerance, result, tt.expected)
+			}
+			if result := b.SameContentTolerant(a, tt.tolerance); result != tt.expected {
+				t.Errorf("SameContentTolerant(%q, %q, %d) = %v, want %v", tt.b, tt.a, tt.tolerance, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMatchKey(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", "matrix|1999||"},
+		{"The.Matrix.1999.2160p.WEB-DL.x265-GROUP", "matrix|1999||"},
+		{"Breaking.Bad.S01E01.720p.HDTV.x264-CTU", "breaking bad||1|1"},
+		{"Breaking.Bad.S01.1080p.BluRay.x264-ROVERS", "breaking bad||1|"},
+		{"Doctor.Who.2005.S00E01.720p.HDTV.x264-GROUP", "doctor who|2005|0|1"},
+		{"Doctor.Who.2005.1080p.BluRay.x264-GROUP", "doctor who|2005||"},
+		{"The.Office.US.S01E01.720p.HDTV.x264-GROUP", "office us||1|1"},
+		{"The.Office.UK.S01E01.720p.HDTV.x264-GROUP", "office uk||1|1"},
+		{"", "|||"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := Parse(tt.input).MatchKey(); got != tt.expected {
+				t.Errorf("MatchKey() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestQualityScore(t *testing.T) {
+	ordered := []string{
+		"Movie.2019.2160p.BluRay.REMUX.HEVC-GROUP",
+		"Movie.2019.2160p.WEB-DL.x265-GROUP",
+		"Movie.2019.1080p.BluRay.x265-GROUP",
+		"Movie.2019.1080p.BluRay.x264-GROUP",
+		"Movie.2019.1080p.WEBRip.x264-GROUP",
+		"Movie.2019.720p.WEBRip.x264-GROUP",
+		"Movie.2019.720p.HDTV.x264-GROUP",
+		"Movie.2019.CAM.x264-GROUP",
+	}
+
+	for i := 1; i < len(ordered); i++ {
+		better, worse := Parse(ordered[i-1]), Parse(ordered[i])
+		if better.QualityScore() <= worse.QualityScore() {
+			t.Errorf("QualityScore(%q) = %d, want more than QualityScore(%q) = %d",
+				ordered[i-1], better.QualityScore(), ordered[i], worse.QualityScore())
+		}
+	}
+}
+
+func TestQualityScoreWith(t *testing.T) {
+	// Prefer efficient codecs over source
+	ranks := QualityRanks{
+		Resolution: DefaultQualityRanks.Resolution,
+		Source:     map[string]int{"BluRay": 1, "WEBRip": 1},
+		Codec:      map[string]int{"H265": 2, "H264": 1},
+	}
+	webrip := Parse("Movie.2019.1080p.WEBRip.x265-GROUP")
+	bluray := Parse("Movie.2019.1080p.BluRay.x264-GROUP")
+	if webrip.QualityScoreWith(ranks) <= bluray.QualityScoreWith(ranks) {
+		t.Errorf("custom ranks: WEBRip x265 scored %d, BluRay x264 scored %d", webrip.QualityScoreWith(ranks), bluray.QualityScoreWith(ranks))
+	}
+}
@@ -0,0 +1,116 @@
+package torrentname
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// gluedTokenPattern finds quality tokens without word boundaries, for names
+// written without separators (TheMatrix1999.1080pBluRayx264SPARKS). A year is
+// only taken when no digit touches it.
+var gluedTokenPattern = regexp.MustCompile(`(?i)(4320p|2160p|1440p|1080p|720p|576p|480p|Blu-?Ray|WEB-?DL|WEBRip|HDTV|DVDRip|BDRip|BRRip|REMUX|x264|x265|H264|H265|HEVC|XviD)|(19\d{2}|20\d{2})`)
+
+// minGluedTokens is how many quality tokens a name needs before it is read as
+// glued; a lone "1080p" in "Movie 1080p" is handled by the regular scans
+const minGluedTokens = 2
+
+// respaceGlued splits a name written with at most one separator at its glued
+// quality tokens and camel-case title words, so the regular scans can read it:
+// "TheMatrix1999.1080pBluRayx264SPARKS" becomes
+// "The Matrix 1999.1080p BluRay x264-SPARKS". It also returns, for each byte of
+// the new name, the position in name it came from (len(name) at the end).
+// ok is false when name has separators or too few glued tokens to rewrite.
+func respaceGlued(name string) (spaced string, offsets []int, ok bool) {
+	body := name
+	if loc := containerPattern.FindStringIndex(body); loc != nil {
+		body = body[:loc[0]]
+	}
+	separators := 0
+	for i := 0; i < len(body); i++ {
+		if isSeparatorByte(body[i]) {
+			separators++
+		}
+	}
+	if separators > 1 {
+		return "", nil, false
+	}
+
+	var tokens [][]int
+	quality := 0
+	for _, m := range gluedTokenPattern.FindAllStringSubmatchIndex(body, -1) {
+		if m[2] < 0 && (m[0] > 0 && isDigit(body[m[0]-1]) || m[1] < len(body) && isDigit(body[m[1]])) {
+			continue
+		}
+		if m[2] >= 0 {
+			quality++
+		}
+		tokens = append(tokens, m[:2])
+	}
+	if quality < minGluedTokens {
+		return "", nil, false
+	}
+
+	var b strings.Builder
+	offsets = make([]int, 0, len(name)+2*len(tokens)+8)
+	write := func(s string, from int) {
+		for i := 0; i < len(s); i++ {
+			b.WriteByte(s[i])
+			offsets = append(offsets, from+i)
+		}
+	}
+	space := func(sep byte, at int) {
+		b.WriteByte(sep)
+		offsets = append(offsets, at)
+	}
+
+	// The title is the run before the first token, split at its camel case.
+	// Runes are decoded whole so a multibyte letter is never split.
+	first := tokens[0][0]
+	for i := 0; i < first; {
+		r, size := utf8.DecodeRuneInString(body[i:first])
+		if i > 0 && unicode.IsUpper(r) {
+			if prev, _ := utf8.DecodeLastRuneInString(body[:i]); unicode.IsLower(prev) {
+				space(' ', i)
+			}
+		}
+		write(body[i:i+size], i)
+		i += size
+	}
+
+	pos := first
+	for _, t := range tokens {
+		if t[0] > pos {
+			write(body[pos:t[0]], pos)
+		}
+		if b.Len() > 0 && !isSeparatorByte(body[t[0]-1]) {
+			space(' ', t[0])
+		}
+		write(body[t[0]:t[1]], t[0])
+		pos = t[1]
+	}
+
+	// A trailing run after the last token is the release group
+	if rest := body[pos:]; len(rest) >= 2 && !isSeparatorByte(rest[0]) {
+		space('-', pos)
+	}
+	write(name[pos:], pos)
+	offsets = append(offsets, len(name))
+	// A name already spaced as far as it goes (x264Title 1080p) would be
+	// rewritten to itself forever
+	if b.String() == name {
+		return "", nil, false
+	}
+	return b.String(), offsets, true
+}
+
+// isDigit reports whether c is an ASCII digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isSeparatorByte reports whether c separates words in a release name
+func isSeparatorByte(c byte) bool {
+	return c == '.' || c == ' ' || c == '_' || c == '-'
+}
@@ -0,0 +1,109 @@
+package torrentname
+
+import "testing"
+
+func TestGluedNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *TorrentInfo
+	}{
+		{
+			name:  "one separator",
+			input: "TheMatrix1999.1080pBluRayx264SPARKS",
+			expected: &TorrentInfo{
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "no separators and a container",
+			input: "Inception2010BluRay720pHEVC.mkv",
+			expected: &TorrentInfo{
+				Title:      "Inception",
+				Year:       2010,
+				Resolution: "720p",
+				Source:     "BluRay",
+				Codec:      "H265",
+				Container:  "mkv",
+				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "episode code in the camel case run",
+			input: "BreakingBadS01E01720pHDTVx264",
+			expected: &TorrentInfo{
+				Title:      "Breaking Bad",
+				Season:     1,
+				Episode:    1,
+				Resolution: "720p",
+				Source:     "HDTV",
+				Codec:      "H264",
+				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			// Respacing gives "x264Title 1080p", which respaces to itself
+			name:  "already spaced after one pass",
+			input: "x264Title1080p",
+			expected: &TorrentInfo{
+				Title:      "x264Title",
+				Resolution: "1080p",
+				Confidence: ResolutionWeight,
+			},
+		},
+		{
+			name:  "accented camel case",
+			input: "ÉcoleNormale1999.1080pBluRayx264SPARKS",
+			expected: &TorrentInfo{
+				Title:        "École Normale",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "multibyte title already spaced after one pass",
+			input: "x264Amélie1080p",
+			expected: &TorrentInfo{
+				Title:      "x264Amélie",
+				Resolution: "1080p",
+				Confidence: ResolutionWeight,
+			},
+		},
+		{
+			// A single quality token is left to the regular scans
+			name:  "one glued token",
+			input: "TheMatrix1999",
+			expected: &TorrentInfo{
+				Title: "TheMatrix1999",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareTorrentInfo(t, Parse(tt.input), tt.expected)
+		})
+	}
+}
+
+func TestGluedBoundary(t *testing.T) {
+	input := "TheMatrix1999.1080pBluRayx264SPARKS"
+	if got := input[MetadataBoundary(input):]; got != "1999.1080pBluRayx264SPARKS" {
+		t.Errorf("metadata: got %q, want %q", got, "1999.1080pBluRayx264SPARKS")
+	}
+
+	p := NewParser(WithRawTitle(true))
+	if raw := p.Parse(input).RawTitle; raw != "TheMatrix" {
+		t.Errorf("RawTitle: got %q, want %q", raw, "TheMatrix")
+	}
+}
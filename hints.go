@@ -0,0 +1,108 @@
+package torrentname
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// hint adjusts a parse for a tracker's naming conventions
+type hint func(p *Parser, name string, info *TorrentInfo)
+
+var (
+	hintsMu sync.RWMutex
+	hints   = make(map[string]hint) // lower-cased tracker name -> hint
+)
+
+func init() {
+	// BTN uses "Season X Complete" format
+	btn := func(p *Parser, name string, info *TorrentInfo) {
+		if match := btnSeasonPack.FindStringSubmatch(name); match != nil {
+			info.Season, _ = strconv.Atoi(match[1])
+			info.HasSeason = true
+			info.IsComplete = true
+		}
+	}
+	// PTP sometimes uses year ranges for collections
+	ptp := func(p *Parser, name string, info *TorrentInfo) {
+		if match := ptnYearRange.FindStringSubmatch(name); match != nil {
+			info.Year, _ = strconv.Atoi(match[1])
+			info.YearEnd, _ = strconv.Atoi(match[2])
+		}
+	}
+	// HDBits has very standardized naming
+	hdb := func(p *Parser, name string, info *TorrentInfo) {
+		info.Confidence = boostConfidence(info.Confidence, p.hdbitsBoost)
+	}
+
+	for tracker, h := range map[string]hint{
+		"btn": btn, "broadcasthenet": btn,
+		"ptp": ptp, "passthepopcorn": ptp,
+		"hdb": hdb, "hdbits": hdb,
+	} {
+		registerHint(tracker, h)
+	}
+}
+
+// RegisterHint registers fn as the hint for tracker, replacing any earlier
+// hint for it, built-in ones included. Tracker names are matched without
+// regard to case. ParseWithHints calls fn with the name and the result of the
+// base parse, which fn may adjust in place. A nil fn removes the hint.
+// RegisterHint is safe to call concurrently with parsing.
+func RegisterHint(tracker string, fn func(name string, info *TorrentInfo)) {
+	if fn == nil {
+		registerHint(tracker, nil)
+		return
+	}
+	registerHint(tracker, func(p *Parser, name string, info *TorrentInfo) {
+		fn(name, info)
+	})
+}
+
+// registerHint stores h for tracker, deleting the entry when h is nil
+func registerHint(tracker string, h hint) {
+	hintsMu.Lock()
+	defer hintsMu.Unlock()
+	if h == nil {
+		delete(hints, strings.ToLower(tracker))
+		return
+	}
+	hints[strings.ToLower(tracker)] = h
+}
+
+// ParseWithHints parses with tracker-specific hints using the default Parser
+func ParseWithHints(name string, tracker string) *TorrentInfo {
+	return defaultParser.ParseWithHints(name, tracker)
+}
+
+// ParseWithHints parses name and then applies the hint registered for
+// tracker, if any. Built-in hints cover BTN, PTP and HDBits.
+func (p *Parser) ParseWithHints(name string, tracker string) *TorrentInfo {
+	info := p.Parse(name)
+
+	// Input validation
+	if name == "" {
+		return info // Empty result with 0 confidence
+	}
+
+	hintsMu.RLock()
+	h := hints[strings.ToLower(tracker)]
+	hintsMu.RUnlock()
+
+	if h != nil {
+		h(p, name, info)
+	}
+	return info
+}
+
+// boostConfidence scales confidence by factor, rounding down and capping the
+// result at 100 (90 * 1.1 = 99, 95 * 1.1 = 100)
+func boostConfidence(confidence int, factor float64) int {
+	// The epsilon absorbs float error such as 100 * 1.15 = 114.999...
+	boosted := int(math.Floor(float64(confidence)*factor + 1e-9))
+	if boosted > 100 {
+		return 100
+	}
+	return boosted
+}
@@ -0,0 +1,81 @@
+package torrentname
+
+import "testing"
+
+func TestParseWithHintsBuiltins(t *testing.T) {
+	result := ParseWithHints("Breaking.Bad.S01.Complete.720p.BluRay.x264-DEMAND", "BTN")
+	if result.Season != 1 || !result.IsComplete {
+		t.Errorf("BTN: got Season %d IsComplete %v, want 1 and true", result.Season, result.IsComplete)
+	}
+
+	result = ParseWithHints("Trilogy.1999-2003.1080p.BluRay.x264-GROUP", "PTP")
+	if result.Year != 1999 || result.YearEnd != 2003 {
+		t.Errorf("PTP: got Year %d YearEnd %d, want 1999 and 2003", result.Year, result.YearEnd)
+	}
+
+	name := "The.Dark.Knight.2008.1080p.BluRay.DTS.x264-ESiR"
+	if result, want := ParseWithHints(name, "HDBits"), boostConfidence(Parse(name).Confidence, DefaultHDBitsBoost); result.Confidence != want {
+		t.Errorf("HDBits: got Confidence %d, want %d", result.Confidence, want)
+	}
+
+	if result, want := ParseWithHints(name, "unknown"), Parse(name); result.Confidence != want.Confidence {
+		t.Errorf("unknown tracker: got Confidence %d, want %d", result.Confidence, want.Confidence)
+	}
+}
+
+func TestRegisterHint(t *testing.T) {
+	RegisterHint("MyTracker", func(name string, info *TorrentInfo) {
+		info.ReleaseGroup = "MINE"
+	})
+	defer RegisterHint("mytracker", nil)
+
+	name := "The.Matrix.1999.1080p.BluRay.x264-SPARKS"
+	if result := ParseWithHints(name, "mytracker"); result.ReleaseGroup != "MINE" {
+		t.Errorf("ReleaseGroup: got %q, want %q", result.ReleaseGroup, "MINE")
+	}
+	if result := NewParser().ParseWithHints(name, "MYTRACKER"); result.ReleaseGroup != "MINE" {
+		t.Errorf("ReleaseGroup via Parser: got %q, want %q", result.ReleaseGroup, "MINE")
+	}
+
+	RegisterHint("mytracker", nil)
+	if result := ParseWithHints(name, "mytracker"); result.ReleaseGroup != "SPARKS" {
+		t.Errorf("ReleaseGroup after removal: got %q, want %q", result.ReleaseGroup, "SPARKS")
+	}
+}
+
+func TestRegisterHintOverridesBuiltin(t *testing.T) {
+	hintsMu.RLock()
+	builtin := hints["ptp"]
+	hintsMu.RUnlock()
+	t.Cleanup(func() { registerHint("ptp", builtin) })
+
+	RegisterHint("ptp", func(name string, info *TorrentInfo) {
+		info.Edition = "Collection"
+	})
+
+	if result := ParseWithHints("Trilogy.1999-2003.1080p.BluRay.x264-GROUP", "PTP"); result.Edition != "Collection" {
+		t.Errorf("Edition: got %q, want %q", result.Edition, "Collection")
+	}
+}
+
+func TestBoostConfidence(t *testing.T) {
+	tests := []struct {
+		confidence int
+		factor     float64
+		expected   int
+	}{
+		{90, DefaultHDBitsBoost, 99},
+		{95, DefaultHDBitsBoost, 100},
+		{82, DefaultHDBitsBoost, 90},
+		{0, DefaultHDBitsBoost, 0},
+		{100, 1.15, 100},
+		{60, 1.15, 69},
+		{82, 1, 82},
+	}
+
+	for _, tt := range tests {
+		if result := boostConfidence(tt.confidence, tt.factor); result != tt.expected {
+			t.Errorf("boostConfidence(%d, %v) = %d, want %d", tt.confidence, tt.factor, result, tt.expected)
+		}
+	}
+}
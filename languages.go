@@ -0,0 +1,137 @@
+package torrentname
+
+import (
+	"regexp"
+	"strings"
+)
+
+// languageTable lists the languages recognized in release names: the display
+// name, the full names and scene tags that denote it, its ISO 639-2 codes and
+// its ISO 639-1 code. Many codes are also words (Cat, May, Fin), so outside of
+// audio track lists they only count when written in capitals. The two-letter
+// codes are too short to pick out of a name and are only read from subtitle
+// file names (Movie.en.srt).
+var languageTable = []struct {
+	name  string
+	names []string
+	codes []string
+	iso1  string
+}{
+	{"English", []string{"ENGLISH"}, []string{"ENG"}, "en"},
+	{"French", []string{"FRENCH", "TRUEFRENCH", "VFF", "VFQ", "VFI", "VOF", "VF2"}, []string{"FRE", "FRA"}, "fr"},
+	{"Spanish", []string{"SPANISH", "CASTELLANO"}, []string{"SPA", "ESP"}, "es"},
+	{"German", []string{"GERMAN"}, []string{"GER", "DEU"}, "de"},
+	{"Italian", []string{"ITALIAN"}, []string{"ITA"}, "it"},
+	{"Portuguese", []string{"PORTUGUESE"}, []string{"POR"}, "pt"},
+	{"Dutch", []string{"DUTCH", "FLEMISH"}, []string{"DUT", "NLD"}, "nl"},
+	{"Danish", []string{"DANISH"}, []string{"DAN"}, "da"},
+	{"Swedish", []string{"SWEDISH"}, []string{"SWE"}, "sv"},
+	{"Norwegian", []string{"NORWEGIAN"}, []string{"NOR"}, "no"},
+	{"Finnish", []string{"FINNISH"}, []string{"FIN"}, "fi"},
+	{"Icelandic", []string{"ICELANDIC"}, []string{"ICE", "ISL"}, "is"},
+	{"Russian", []string{"RUSSIAN"}, []string{"RUS"}, "ru"},
+	{"Ukrainian", []string{"UKRAINIAN"}, []string{"UKR"}, "uk"},
+	{"Polish", []string{"POLISH"}, []string{"POL"}, "pl"},
+	{"Czech", []string{"CZECH"}, []string{"CZE", "CES"}, "cs"},
+	{"Slovak", []string{"SLOVAK"}, []string{"SLO", "SLK"}, "sk"},
+	{"Hungarian", []string{"HUNGARIAN"}, []string{"HUN"}, "hu"},
+	{"Romanian", []string{"ROMANIAN"}, []string{"RUM", "RON"}, "ro"},
+	{"Bulgarian", []string{"BULGARIAN"}, []string{"BUL"}, "bg"},
+	{"Croatian", []string{"CROATIAN"}, []string{"HRV"}, "hr"},
+	{"Serbian", []string{"SERBIAN"}, []string{"SRP"}, "sr"},
+	{"Slovenian", []string{"SLOVENIAN"}, []string{"SLV"}, "sl"},
+	{"Greek", []string{"GREEK"}, []string{"GRE", "ELL"}, "el"},
+	{"Turkish", []string{"TURKISH"}, []string{"TUR"}, "tr"},
+	{"Estonian", []string{"ESTONIAN"}, []string{"EST"}, "et"},
+	{"Latvian", []string{"LATVIAN"}, []string{"LAV"}, "lv"},
+	{"Lithuanian", []string{"LITHUANIAN"}, []string{"LIT"}, "lt"},
+	{"Catalan", []string{"CATALAN"}, []string{"CAT"}, "ca"},
+	{"Arabic", []string{"ARABIC"}, []string{"ARA"}, "ar"},
+	{"Hebrew", []string{"HEBREW"}, []string{"HEB"}, "he"},
+	{"Persian", []string{"PERSIAN", "FARSI"}, []string{"PER", "FAS"}, "fa"},
+	{"Hindi", []string{"HINDI"}, []string{"HIN"}, "hi"},
+	{"Tamil", []string{"TAMIL"}, []string{"TAM"}, "ta"},
+	{"Telugu", []string{"TELUGU"}, []string{"TEL"}, "te"},
+	{"Thai", []string{"THAI"}, []string{"THA"}, "th"},
+	{"Vietnamese", []string{"VIETNAMESE"}, []string{"VIE"}, "vi"},
+	{"Indonesian", []string{"INDONESIAN"}, []string{"IND"}, "id"},
+	{"Malay", []string{"MALAY"}, []string{"MAY", "MSA"}, "ms"},
+	{"Tagalog", []string{"TAGALOG", "FILIPINO"}, []string{"TGL", "FIL"}, "tl"},
+	{"Japanese", []string{"JAPANESE"}, []string{"JPN", "JAP"}, "ja"},
+	{"Korean", []string{"KOREAN"}, []string{"KOR"}, "ko"},
+	{"Chinese", []string{"CHINESE"}, []string{"CHI", "ZHO"}, "zh"},
+	{"Cantonese", []string{"CANTONESE"}, nil, ""},
+	{"Mandarin", []string{"MANDARIN"}, nil, ""},
+}
+
+// languageNames maps every upper-cased name and code in languageTable to its
+// language
+var languageNames = func() map[string]string {
+	names := make(map[string]string)
+	for _, language := range languageTable {
+		for _, token := range language.names {
+			names[token] = language.name
+		}
+		for _, token := range language.codes {
+			names[token] = language.name
+		}
+	}
+	return names
+}()
+
+var (
+	// languagePattern matches full language names and MULTI, which marks a
+	// release with several audio languages
+	languagePattern = languageTokenPattern("(?i)", languageNameTokens, "MULTI")
+	// languageCodePattern matches language codes written in capitals
+	languageCodePattern = languageTokenPattern("", languageCodeTokens)
+	// Audio track languages - only meaningful next to audio tokens
+	audioLanguagePattern = languageTokenPattern("(?i)", languageAllTokens)
+)
+
+// languageNameTokens, languageCodeTokens and languageAllTokens select
+// languageTable columns
+func languageNameTokens(names, _ []string) []string { return names }
+func languageCodeTokens(_, codes []string) []string { return codes }
+func languageAllTokens(names, codes []string) []string {
+	return append(append([]string(nil), names...), codes...)
+}
+
+// languageTokenPattern compiles a whole-word pattern with the given flags for
+// the languageTable tokens pick selects, plus extra
+func languageTokenPattern(flags string, pick func(names, codes []string) []string, extra ...string) *regexp.Regexp {
+	tokens := extra
+	for _, language := range languageTable {
+		tokens = append(tokens, pick(language.names, language.codes)...)
+	}
+	return regexp.MustCompile(flags + `\b(` + strings.Join(tokens, "|") + `)\b`)
+}
+
+// shortLanguageNames maps the lower-cased ISO 639-1 codes in languageTable to
+// their language
+var shortLanguageNames = func() map[string]string {
+	names := make(map[string]string)
+	for _, language := range languageTable {
+		if language.iso1 != "" {
+			names[language.iso1] = language.name
+		}
+	}
+	return names
+}()
+
+// subtitleLanguageName maps the language token of a subtitle file name (en, eng,
+// English) to its language, or returns "" when it is not one
+func subtitleLanguageName(token string) string {
+	if name, ok := shortLanguageNames[strings.ToLower(token)]; ok {
+		return name
+	}
+	return languageNames[strings.ToUpper(token)]
+}
+
+// languageName maps a language token to its display name
+func languageName(token string) string {
+	if name, ok := languageNames[strings.ToUpper(token)]; ok {
+		return name
+	}
+	return titleCase(token)
+}
@@ -0,0 +1,144 @@
+package torrentname
+
+// ParsePair parses a release folder name and the name of a file inside it with
+// the default Parser and merges the results. See Parser.ParsePair.
+func ParsePair(folder, file string) *TorrentInfo {
+	return defaultParser.ParsePair(folder, file)
+}
+
+// ParsePair parses a release folder name and the name of a file inside it and
+// merges the two results. Folders usually carry the full release name while
+// files carry the episode and container, so the merge takes:
+//
+//   - Season, HasSeason, SeasonEnd, Episode, EpisodeEnd, EpisodeCount, EpisodeType,
+//     AbsoluteEpisode, EpisodeTitle and IsSeasonPack from the file whenever the file
+//     names a season or episode, otherwise from the folder;
+//   - Container and FileSize from the file, falling back to the folder, as the
+//     folder's size is that of the whole release;
+//   - Unparsed and Ignored from the folder only;
+//   - every other field from the folder, falling back to the file where the
+//     folder left it empty. Flags such as IsProper are set if either side has them.
+//
+// Kind, Is4K, IsHD and Confidence are recomputed from the merged result, and each
+// FieldConfidence entry comes from the side its field was taken from.
+func (p *Parser) ParsePair(folder, file string) *TorrentInfo {
+	f := p.Parse(folder)
+	g := p.Parse(file)
+
+	info := &TorrentInfo{
+		Title:            orString(f.Title, g.Title),
+		RawTitle:         orString(f.RawTitle, g.RawTitle),
+		Country:          orString(f.Country, g.Country),
+		Year:             orInt(f.Year, g.Year),
+		RemasterYear:     orInt(f.RemasterYear, g.RemasterYear),
+		Date:             orString(f.Date, g.Date),
+		Resolution:       orString(f.Resolution, g.Resolution),
+		FrameRate:        orInt(f.FrameRate, g.FrameRate),
+		Source:           orString(f.Source, g.Source),
+		SourceRaw:        orString(f.SourceRaw, g.SourceRaw),
+		DiscType:         orString(f.DiscType, g.DiscType),
+		Codec:            orString(f.Codec, g.Codec),
+		CodecRaw:         orString(f.CodecRaw, g.CodecRaw),
+		EncoderCodec:     orString(f.EncoderCodec, g.EncoderCodec),
+		HDR:              orString(f.HDR, g.HDR),
+		Audio:            orString(f.Audio, g.Audio),
+		AudioBitrate:     orString(f.AudioBitrate, g.AudioBitrate),
+		AudioBitDepth:    orString(f.AudioBitDepth, g.AudioBitDepth),
+		AudioSampleRate:  orString(f.AudioSampleRate, g.AudioSampleRate),
+		ReleaseGroup:     orString(f.ReleaseGroup, g.ReleaseGroup),
+		IndexerTag:       orString(f.IndexerTag, g.IndexerTag),
+		Container:        orString(g.Container, f.Container),
+		IsSubtitle:       f.IsSubtitle || g.IsSubtitle,
+		FileSize:         orString(g.FileSize, f.FileSize),
+		Language:         orString(f.Language, g.Language),
+		Languages:        f.Languages,
+		AudioLanguages:   f.AudioLanguages,
+		Subtitles:        f.Subtitles,
+		SceneTags:        f.SceneTags,
+		IsComplete:       f.IsComplete || g.IsComplete,
+		IsCompleteSeries: f.IsCompleteSeries || g.IsCompleteSeries,
+		IsMiniseries:     f.IsMiniseries || g.IsMiniseries,
+		IsProper:         f.IsProper || g.IsProper,
+		IsRepack:         f.IsRepack || g.IsRepack,
+		IsHardcoded:      f.IsHardcoded || g.IsHardcoded,
+		IsDubbed:         f.IsDubbed || g.IsDubbed,
+		Is3D:             f.Is3D || g.Is3D,
+		ThreeDLayout:     orString(f.ThreeDLayout, g.ThreeDLayout),
+		Edition:          orString(f.Edition, g.Edition),
+		IsExtra:          f.IsExtra || g.IsExtra,
+		ExtraType:        orString(f.ExtraType, g.ExtraType),
+		Volume:           orInt(f.Volume, g.Volume),
+		Part:             orInt(f.Part, g.Part),
+		ListIndex:        orInt(f.ListIndex, g.ListIndex),
+		Unparsed:         f.Unparsed,
+		Ignored:          f.Ignored,
+	}
+	if len(info.Languages) == 0 {
+		info.Languages = g.Languages
+	}
+	if len(info.AudioLanguages) == 0 {
+		info.AudioLanguages = g.AudioLanguages
+	}
+	if len(info.Subtitles) == 0 {
+		info.Subtitles = g.Subtitles
+	}
+	if len(info.SceneTags) == 0 {
+		info.SceneTags = g.SceneTags
+	}
+
+	// Episode numbering moves as a unit so ranges and types stay consistent
+	episodes := f
+	if g.HasSeason || g.Episode != 0 || g.AbsoluteEpisode != 0 {
+		episodes = g
+	}
+	info.Season = episodes.Season
+	info.HasSeason = episodes.HasSeason
+	info.SeasonEnd = episodes.SeasonEnd
+	info.Episode = episodes.Episode
+	info.EpisodeEnd = episodes.EpisodeEnd
+	info.EpisodeCount = episodes.EpisodeCount
+	info.EpisodeType = episodes.EpisodeType
+	info.AbsoluteEpisode = episodes.AbsoluteEpisode
+	info.EpisodeTitle = episodes.EpisodeTitle
+	info.IsSeasonPack = episodes.IsSeasonPack
+
+	info.setKind()
+	info.setResolutionFlags()
+	info.calculateConfidence(p.weights)
+
+	titles, years := f, f
+	if f.Title == "" {
+		titles = g
+	}
+	if f.Year == 0 {
+		years = g
+	}
+	info.YearEnd = years.YearEnd
+	fc := make(map[string]float64, 4)
+	for field, from := range map[string]*TorrentInfo{"Title": titles, "Year": years, "Season": episodes, "Episode": episodes} {
+		if c, ok := from.FieldConfidence[field]; ok {
+			fc[field] = c
+		}
+	}
+	if len(fc) > 0 {
+		info.FieldConfidence = fc
+	}
+
+	return info
+}
+
+// orString returns a, or b when a is empty
+func orString(a, b string) string {
+	if a != "" {
+		return a
+	}
+	return b
+}
+
+// orInt returns a, or b when a is 0
+func orInt(a, b int) int {
+	if a != 0 {
+		return a
+	}
+	return b
+}
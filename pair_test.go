@@ -0,0 +1,91 @@
+package torrentname
+
+import "testing"
+
+func TestParsePair(t *testing.T) {
+	tests := []struct {
+		name     string
+		folder   string
+		file     string
+		expected *TorrentInfo
+	}{
+		{
+			name:   "movie folder with obfuscated file",
+			folder: "The.Matrix.1999.1080p.BluRay-GROUP",
+			file:   "matrix-sparks.mkv",
+			expected: &TorrentInfo{
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Container:    "mkv",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:   "season pack folder with episode file",
+			folder: "Breaking.Bad.S01.1080p.BluRay.x264-ROVERS",
+			file:   "Breaking.Bad.S01E03.mkv",
+			expected: &TorrentInfo{
+				Title:        "Breaking Bad",
+				Season:       1,
+				Episode:      3,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "ROVERS",
+				Container:    "mkv",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:   "sizes on both sides",
+			folder: "Breaking.Bad.S01.1080p.BluRay.x264-ROVERS (12.5GB)",
+			file:   "Breaking.Bad.S01E03.mkv (1.4GB)",
+			expected: &TorrentInfo{
+				Title:        "Breaking Bad",
+				Season:       1,
+				Episode:      3,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "ROVERS",
+				Container:    "mkv",
+				FileSize:     "1.4GB",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:   "file fills fields the folder lacks",
+			folder: "Some Movie",
+			file:   "Some.Movie.2010.720p.WEBRip.x264-GROUP.mp4",
+			expected: &TorrentInfo{
+				Title:        "Some Movie",
+				Year:         2010,
+				Resolution:   "720p",
+				Source:       "WEBRip",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Container:    "mp4",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareTorrentInfo(t, ParsePair(tt.folder, tt.file), tt.expected)
+		})
+	}
+}
+
+func TestParsePairFieldConfidence(t *testing.T) {
+	fc := ParsePair("Some.Show.2010.1080p.WEB-DL-GROUP", "Some.Show.1x03.mkv").FieldConfidence
+	want := map[string]float64{"Title": sureField, "Year": sureField, "Season": likelyField, "Episode": likelyField}
+	for field, c := range want {
+		if fc[field] != c {
+			t.Errorf("FieldConfidence[%q]: got %v, want %v", field, fc[field], c)
+		}
+	}
+}
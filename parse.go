@@ -2,10 +2,19 @@
 package torrentname
 
 import (
+	"fmt"
+	"math"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
-	"time"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
 // Confidence scoring weights
@@ -17,83 +26,357 @@ const (
 	MinorFieldWeight   = 1
 )
 
+// Kinds of release reported in TorrentInfo.Kind
+const (
+	KindMovie   = "movie"   // No season, episode or air date
+	KindEpisode = "episode" // One episode or episode range, by number or air date
+	KindSeason  = "season"  // Whole season(s) or a complete series
+)
+
 // TorrentInfo contains all metadata parsed from a torrent name
 type TorrentInfo struct {
-	Title        string   `json:"title"`
-	Year         int      `json:"year,omitempty"`
-	Date         string   `json:"date,omitempty"` // For daily shows (YYYY.MM.DD format)
-	Season       int      `json:"season,omitempty"`
-	Episode      int      `json:"episode,omitempty"` // Single episode number
-	Resolution   string   `json:"resolution,omitempty"`
-	Source       string   `json:"source,omitempty"`
-	Codec        string   `json:"codec,omitempty"`
-	Audio        string   `json:"audio,omitempty"`
-	ReleaseGroup string   `json:"release_group,omitempty"`
-	Container    string   `json:"container,omitempty"`
-	Language     string   `json:"language,omitempty"`
-	Subtitles    []string `json:"subtitles,omitempty"`
-	IsComplete   bool     `json:"is_complete,omitempty"`
-	IsProper     bool     `json:"is_proper,omitempty"`
-	IsRepack     bool     `json:"is_repack,omitempty"`
-	IsHardcoded  bool     `json:"is_hardcoded,omitempty"`
-	Edition      string   `json:"edition,omitempty"`  // Director's Cut, Extended, etc.
-	Confidence   int      `json:"confidence"`         // 0 to 100
-	Unparsed     string   `json:"unparsed,omitempty"` // Everything after metadata start that isn't metadata
+	Title            string             `json:"title"`
+	Kind             string             `json:"kind,omitempty"`      // KindMovie, KindEpisode or KindSeason, derived from the numbering found
+	RawTitle         string             `json:"raw_title,omitempty"` // Title as it appears in the name; set only WithRawTitle
+	Country          string             `json:"country,omitempty"`   // Country code closing a series title (The.Office.US), kept out of Title
+	Year             int                `json:"year,omitempty"`
+	YearEnd          int                `json:"year_end,omitempty"`      // Last year of a multi-year pack (Collection.2018.2019.2020); Year is the first
+	RemasterYear     int                `json:"remaster_year,omitempty"` // Year after REMASTERED (Movie.1977.REMASTERED.2016); Year stays the original
+	Date             string             `json:"date,omitempty"`          // For daily shows (YYYY.MM.DD format)
+	Season           int                `json:"season,omitempty"`
+	HasSeason        bool               `json:"has_season,omitempty"`       // A season was parsed; tells S00 (specials) from no season
+	SeasonEnd        int                `json:"season_end,omitempty"`       // Last season of a season range (S01-S05)
+	Episode          int                `json:"episode,omitempty"`          // Single episode number
+	EpisodeEnd       int                `json:"episode_end,omitempty"`      // Last episode of an episode range (S01E01-E10)
+	EpisodeCount     int                `json:"episode_count,omitempty"`    // Number of episodes in a pack, when derivable
+	EpisodeType      string             `json:"episode_type,omitempty"`     // OVA, ONA, Special, Movie or Episode
+	AbsoluteEpisode  int                `json:"absolute_episode,omitempty"` // Episode number outside SxxEyy numbering (OVA 02)
+	EpisodeTitle     string             `json:"episode_title,omitempty"`    // Leftover words of an episode release (Pilot), instead of Unparsed
+	Volume           int                `json:"volume,omitempty"`           // Volume number (Vol.2, Volume 2)
+	Part             int                `json:"part,omitempty"`             // Part or disc number (CD1, Part.2, Part.Two)
+	ListIndex        int                `json:"list_index,omitempty"`       // Position prefixed by a sorted listing ([01] or 01. before the title)
+	Resolution       string             `json:"resolution,omitempty"`
+	FrameRate        int                `json:"frame_rate,omitempty"` // Frame rate glued to the resolution (1080p60), rounded to whole frames
+	Is4K             bool               `json:"is_4k,omitempty"`      // Derived from Resolution: 2160p or 4320p
+	IsHD             bool               `json:"is_hd,omitempty"`      // Derived from Resolution: 720p, 1080p or 1440p
+	Source           string             `json:"source,omitempty"`
+	SourceRaw        string             `json:"source_raw,omitempty"` // Source token as written: BLU-RAY, WEBDL, BDRemux, etc.
+	DiscType         string             `json:"disc_type,omitempty"`  // Full-disc capacity tag: BD25, BD50, UHD66, UHD100
+	Codec            string             `json:"codec,omitempty"`
+	CodecRaw         string             `json:"codec_raw,omitempty"`     // Codec token as written, case and all
+	EncoderCodec     string             `json:"encoder_codec,omitempty"` // Codec token as written: x264, x265, H264, HEVC, etc.
+	HDR              string             `json:"hdr,omitempty"`           // HDR formats in name order, joined by " / " (Dolby Vision / HDR10)
+	Audio            string             `json:"audio,omitempty"`
+	AudioBitrate     string             `json:"audio_bitrate,omitempty"`     // 640Kbps
+	AudioBitDepth    string             `json:"audio_bit_depth,omitempty"`   // 16bit, 24bit
+	AudioSampleRate  string             `json:"audio_sample_rate,omitempty"` // 44.1kHz, 96kHz
+	ReleaseGroup     string             `json:"release_group,omitempty"`
+	IndexerTag       string             `json:"indexer_tag,omitempty"` // Bracketed tag an indexer appends to the group: -ROVERS[rartv] gives rartv
+	Container        string             `json:"container,omitempty"`
+	IsSubtitle       bool               `json:"is_subtitle,omitempty"` // A subtitle file (.srt, .ass, .ssa, .sub, .vtt); Container holds its extension
+	FileSize         string             `json:"file_size,omitempty"`   // Size appended by scrapers in brackets, as written: 1.4GB, 2.3 GiB
+	Language         string             `json:"language,omitempty"`
+	Languages        []string           `json:"languages,omitempty"`       // All languages found, in name order
+	AudioLanguages   []string           `json:"audio_languages,omitempty"` // Languages tagged alongside audio tokens
+	Subtitles        []string           `json:"subtitles,omitempty"`
+	IsComplete       bool               `json:"is_complete,omitempty"`
+	IsCompleteSeries bool               `json:"is_complete_series,omitempty"` // Complete pack spanning all seasons
+	IsMiniseries     bool               `json:"is_miniseries,omitempty"`      // Miniseries or Mini-Series token present
+	IsSeasonPack     bool               `json:"is_season_pack,omitempty"`     // Whole season(s) without episode numbers
+	IsProper         bool               `json:"is_proper,omitempty"`
+	IsRepack         bool               `json:"is_repack,omitempty"`
+	IsHardcoded      bool               `json:"is_hardcoded,omitempty"`
+	IsDubbed         bool               `json:"is_dubbed,omitempty"`  // DUBBED: dubbed audio, as opposed to SUBBED (subtitles only)
+	SceneTags        []string           `json:"scene_tags,omitempty"` // INTERNAL, REAL, RERIP, READNFO, DIRFIX, NFOFIX...
+	Is3D             bool               `json:"is_3d,omitempty"`
+	ThreeDLayout     string             `json:"three_d_layout,omitempty"`   // HSBS, SBS, HOU, OU or MVC
+	Edition          string             `json:"edition,omitempty"`          // Director's Cut, Extended, etc.
+	IsExtra          bool               `json:"is_extra,omitempty"`         // Bonus material rather than the feature itself
+	ExtraType        string             `json:"extra_type,omitempty"`       // Deleted Scenes, Behind the Scenes, Featurette, Making Of, Trailer, Bonus or Extras
+	Confidence       int                `json:"confidence"`                 // 0 to 100
+	FieldConfidence  map[string]float64 `json:"field_confidence,omitempty"` // Per-field confidence from 0 to 1 for Title, Year, Season and Episode
+	Unparsed         string             `json:"unparsed,omitempty"`         // Everything after metadata start that isn't metadata
+	Ignored          []string           `json:"ignored,omitempty"`          // Duplicate metadata tokens that were not used
 }
 
 // Common patterns
 var (
-	yearPattern       = regexp.MustCompile(`\b(19\d{2}|20\d{2})\b`)
-	seasonPattern     = regexp.MustCompile(`(?i)S(\d{1,2})`)
-	seasonAltPattern  = regexp.MustCompile(`(?i)Season[\.\s]?(\d{1,2})`)
-	episodePattern    = regexp.MustCompile(`(?i)S\d{1,2}E(\d{1,3})`)
-	altEpisodePattern = regexp.MustCompile(`(?i)(\d{1,2})x(\d{1,3})`)
-	datePattern       = regexp.MustCompile(`(\d{4})[\.\-](\d{2})[\.\-](\d{2})`)
+	yearPattern = regexp.MustCompile(`\b(19\d{2}|20\d{2})\b`)
+	// Three or more consecutive years (Collection.2018.2019.2020) span a
+	// multi-year pack; a pair is a year-like title and the release year
+	// (Wonder.Woman.1984.2020), left to the scans
+	yearRunPattern = regexp.MustCompile(`\b(?:19\d{2}|20\d{2})(?:[\.\s_](?:19\d{2}|20\d{2})){2,}\b`)
+	seasonPattern  = regexp.MustCompile(`(?i)\bS(\d{1,2})(?:[\.\s_]?-[\.\s_]?S(\d{1,2}))?\b`)
+	// Bare SSEE codes (101 = S01E01, 1205 = S12E05); only used WithNumericEpisodeCodes
+	numericEpisodePattern = regexp.MustCompile(`\b(\d{1,2})(\d{2})\b`)
+	seasonAltPattern      = regexp.MustCompile(`(?i)Seasons?[\.\s]?(\d{1,2})(?:[\.\s]?(?:-|to)[\.\s]?(\d{1,2}))?\b`)
+	// A specials pack is season 0 in word form (Doctor.Who.Specials). Only read
+	// next to other metadata, never as a definite season.
+	specialsPattern    = regexp.MustCompile(`(?i)\bSpecials\b`)
+	episodePattern     = regexp.MustCompile(`(?i)S(\d{1,2})[\.\s_-]?E(\d{1,3})(?:[\.\s_]?-[\.\s_]?E(\d{1,3}))?`)
+	episodeTypePattern = regexp.MustCompile(`(?i)\b(OVA|ONA|OAD)(?:[\.\s-]?(\d{1,3}))?\b`)
+	// Numbered specials and movies (SP1, Movie 2) also end titles (Scary.Movie.2),
+	// so they are only definite metadata in anime-style names; see animeStylePattern
+	numberedEpisodeTypePattern = regexp.MustCompile(`(?i)\b(Special|SP|Movie)[\.\s-]?(\d{1,3})\b`)
+	// A fansub name leads with its [Group] or numbers episodes as "Show - 01"
+	animeStylePattern   = regexp.MustCompile(`^\s*\[[^\]]*\]|\s-\s\d{1,4}\b`)
+	episodeCountPattern = regexp.MustCompile(`(?i)\b(\d{1,3})[\.\s_]?Episodes\b`)
+	altEpisodePattern   = regexp.MustCompile(`(?i)\b(\d{1,2})x(\d{1,3})\b`)
+	datePattern         = regexp.MustCompile(`(\d{4})[\.\-](\d{2})[\.\-](\d{2})`)
 
 	// Quality patterns
-	resolutionPattern = regexp.MustCompile(`(?i)(2160p|4K|1080p|720p|480p|360p)`)
-	sourcePattern     = regexp.MustCompile(`(?i)\b(BLURAY|BLU-RAY|WEB-DL|WEBDL|WEBRIP|WEB|HDTV|CAM|TC|DVD|BRRIP|BDRIP)\b`)
-	codecPattern      = regexp.MustCompile(`(?i)\b(H264|X264|AVC|H265|X265|HEVC|MPEG2|MPEG4)\b`)
+	// High-frame-rate releases glue the rate to the resolution (1080p60, 2160p23.976)
+	resolutionPattern = regexp.MustCompile(`(?i)(4320p|2160p|4K|1440p|1080p|720p|480p|360p)(?:(23\.976|24|25|29\.97|30|50|59\.94|60)\b)?`)
+	// Qualitative resolution tags without a line count; see qualitativeResolutions
+	qualitativeResolutionPattern = regexp.MustCompile(`(?i)\b(FHD|UHD|HD)\b`)
+	// Frame size written as WIDTHxHEIGHT (1920x1080). Widths start at 640 so
+	// smaller NxN pairs are left to titles; see dimensionsResolution
+	dimensionsPattern = regexp.MustCompile(`(?i)\b(6[4-9]\d|[7-9]\d\d|[1-9]\d{3})x(\d{3,4})\b`)
+	sourcePattern     = regexp.MustCompile(`(?i)\b(WEB[-\.\s]?DL[-\.\s]?RIP|HDTVRIP|BDMUX|BLURAY|BLU-RAY|WEB[-\.\s]?DL|WEBRIP|WEB|HDTV|PDTV|SDTV|DSR|DSRIP|SATRIP|CAM|TC|DVD|BRRIP|BDRIP|REMUX|BDREMUX)\b`)
+	webDLHintPattern  = regexp.MustCompile(`(?i)\b(DL|AMZN|NF|NFLX|DSNP|HMAX|ATVP|HULU|PCOK|PMTP)\b`)
+	webRipHintPattern = regexp.MustCompile(`(?i)\b(RIP)\b`)
+	discTypePattern   = regexp.MustCompile(`(?i)\b(BD25|BD50|BD66|BD100|UHD50|UHD66|UHD100)\b`)
+	codecPattern      = regexp.MustCompile(`(?i)\b(H[\.\s]?264|X264|AVC|H[\.\s]?265|X265|HEVC|MPEG2|MPEG4)\b`)
+	// HDR formats. DV and HDR are also words in titles (The.DV.Files), so they
+	// are read after the title or next to the other metadata, never as definite
+	// metadata. HDR10+ ends in a non-word character, so it can't take the
+	// trailing \b.
+	hdrPattern        = regexp.MustCompile(`(?i)\b(HDR10\+|(?:DV|DoVi|Dolby[\.\s]?Vision|HDR10Plus|HDR10|HDR|HLG)\b)`)
 	audioPattern      = regexp.MustCompile(`(?i)\b(AAC|AC3|DTS|FLAC|TRUEHD|MP3|OGG|WAV)\b`)
-
-	// Edition patterns - only match when they're standalone metadata
-	editionPattern = regexp.MustCompile(`(?i)\b(Directors?\.?\s?Cut|Extended\.?\s?Cut|Extended|Unrated|Rated|Theatrical|Final\.?\s?Cut)\b`)
+	audioExtraPattern = regexp.MustCompile(`(?i)\b(ATMOS|DTS-X|DTS-HD|DTS-HD MA|DTS-ES|DDP|DD\+|DD|EAC3|E-AC-3|AC-3)\b`)
+	// Audio codecs glued to their channel layout (DDP5.1, DD5.1, AAC2.0);
+	// DD+5.1 needs no entry as "+" already separates the tokens
+	audioGluedPattern = regexp.MustCompile(`(?i)\b(DDP|DD|EAC3|AC3|AAC|DTS|TRUEHD|OPUS|FLAC)(\d\.\d)\b`)
+
+	// Edition patterns - only match when they're standalone metadata.
+	// Multi-word forms are optional suffixes so the longest form always wins.
+	editionPattern = regexp.MustCompile(`(?i)\b(Director'?s?[\.\s]?Cut|Extended(?:[\.\s]?(?:Cut|Edition))?|Unrated|Rated|Theatrical|Remastered|Final\.?\s?Cut)\b`)
+	// The year of a remaster follows the tag; the release year is the original one
+	remasterYearPattern = regexp.MustCompile(`(?i)\bRemastered[\.\s](19\d{2}|20\d{2})\b`)
+	// The DC abbreviation also starts titles ("DC Super Hero Girls"), so it is only
+	// an edition after the title: past the boundary, or right after the release year
+	dcEditionPattern     = regexp.MustCompile(`(?i)\b(DC)\b`)
+	yearDCEditionPattern = regexp.MustCompile(`(?i)\b(\d{4})[\.\s](DC)\b`)
 
 	// Status patterns - only match when they're standalone metadata
-	completePattern  = regexp.MustCompile(`(?i)\b(Complete)\b`)
-	properPattern    = regexp.MustCompile(`(?i)\b(PROPER)\b`)
-	repackPattern    = regexp.MustCompile(`(?i)\b(REPACK)\b`)
-	hardcodedPattern = regexp.MustCompile(`(?i)\b(HC|HARDCODED)\b`)
+	completePattern   = regexp.MustCompile(`(?i)\b(Complete(?:[\.\s_]?Series)?|Full[\.\s_]?(?:Season|Series)|All[\.\s_]?Episodes)\b`)
+	miniseriesPattern = regexp.MustCompile(`(?i)\bMini[\.\s_-]?Series\b`)
+	properPattern     = regexp.MustCompile(`(?i)\b(PROPER)\b`)
+	repackPattern     = regexp.MustCompile(`(?i)\b(REPACK)\b`)
+	hardcodedPattern  = regexp.MustCompile(`(?i)\b(HC|HARDCODED)\b`)
+	dubbedPattern     = regexp.MustCompile(`(?i)\b(DUBBED)\b`)
+	// Bonus material classifiers. Only read past the title boundary, so a title
+	// word like "Bonus" (The.Bonus.Round) never counts.
+	extraPattern = regexp.MustCompile(`(?i)\b(Deleted[\.\s_-]?Scenes|Behind[\.\s_-]?the[\.\s_-]?Scenes|Making[\.\s_-]Of|Featurettes?|Trailers?|Bonus|Extras)\b`)
+	// Extra classifiers that are ordinary words too; after the title they only
+	// count between other metadata (S01E03.Bonus.Round is an episode title)
+	bareExtraPattern = regexp.MustCompile(`(?i)\b(Trailers?|Bonus|Extras)\b`)
+	// Scene release tags; REAL only qualifies a following PROPER or REPACK
+	sceneTagPattern = regexp.MustCompile(`(?i)\b(INTERNAL|REAL|RERIP|READNFO|DIRFIX|NFOFIX|SUBFIX|SYNCFIX|SAMPLEFIX|PROOFFIX)\b`)
+
+	// 3D patterns. A bare 3D also ends titles ("Step Up 3D"), so in front of
+	// the other metadata it only counts right after the release year.
+	threeDPattern       = regexp.MustCompile(`(?i)\b(3D)\b`)
+	yearThreeDPattern   = regexp.MustCompile(`(?i)\b(\d{4})[\.\s](3D)\b`)
+	threeDLayoutPattern = regexp.MustCompile(`(?i)\b(H-?SBS|HALF[\.\s-]?SBS|F?SBS|H-?OU|HALF[\.\s-]?OU|F?OU|H?TAB|MVC)\b`)
+
+	// Volume numbering. Volumes also end titles ("Kill.Bill.Vol.1.2003",
+	// "Guardians.of.the.Galaxy.Vol.2"), so in front of the other metadata a
+	// volume only counts in anime-style names, where box sets number them.
+	volumePattern = regexp.MustCompile(`(?i)\bVol(?:ume)?[\.\s]?(\d{1,3})\b`)
+	// Part numbering of split releases. "Part" is also a title word, so it only
+	// counts with a number or spelled-out ordinal after it.
+	partPattern = regexp.MustCompile(`(?i)\b(?:CD[\.\s]?(\d{1,2})|Part[\.\s]?(\d{1,2}|One|Two|Three|Four|Five|Six|Seven|Eight|Nine|Ten))\b`)
 
 	// Language patterns
-	languagePattern = regexp.MustCompile(`(?i)\b(ENGLISH|FRENCH|SPANISH|GERMAN|ITALIAN|DANISH|DUTCH|JAPANESE|CANTONESE|MANDARIN|RUSSIAN|POLISH|VIETNAMESE|SWEDISH|NORWEGIAN|FINNISH|TURKISH|PORTUGUESE|KOREAN|MULTI)\b`)
-	subsPattern     = regexp.MustCompile(`(?i)(SUBS|SUBBED|SUB)`)
+	subsPattern        = regexp.MustCompile(`(?i)(SUBS|SUBBED|SUB)`)
+	subLanguagePattern = regexp.MustCompile(`(?i)(ENG|FRE|SPA|GER|ITA|DAN|DUT|JAP|CHI|RUS|POL|VIE|SWE|NOR|FIN|TUR|POR|KOR)[\.\s]?SUBS`)
+	// MULTi directly before SUBS describes the subtitles, not the audio
+	multiSubsPattern = regexp.MustCompile(`(?i)\bMULTI[\.\s-]?SUBS?\b`)
 
 	// Container patterns
 	containerPattern = regexp.MustCompile(`(?i)\.(mkv|mp4|avi|mov|wmv|flv|webm)$`)
+	// The suffix of a .torrent file name (x264-GROUP.mkv.torrent)
+	torrentSuffixPattern = regexp.MustCompile(`(?i)\.torrent$`)
+	// Subtitle file extension, after an optional language token (.en.srt)
+	subtitleFilePattern = regexp.MustCompile(`(?i)(?:\.([a-z]{2,}))?\.(srt|ass|ssa|sub|vtt)$`)
 
 	// Release group pattern
 	releaseGroupPattern = regexp.MustCompile(`-([a-zA-Z0-9]+)(\[[^\]]+\])?$`)
 
+	// Brackets left empty once their metadata is removed
+	emptyBracketsPattern = regexp.MustCompile(`\[[\s\.]*\]|\([\s\.]*\)`)
+
+	// Cleanup patterns for titles and unparsed content
+	datePartPattern    = regexp.MustCompile(`(?i)\b\d{1,2}\.\d{1,2}\b`)
+	bareEpisodePattern = regexp.MustCompile(`(?i)\bE\d{1,3}\b`)
+	nonWordPattern     = regexp.MustCompile(`[^\p{L}\p{N}\s]`)
+
+	// Slug pattern - runs of anything that isn't a lowercase letter or digit
+	slugSeparatorPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+	// Position a sorted listing puts before the title: [01], (1), or a
+	// zero-padded 01 followed by a separator
+	listIndexPattern = regexp.MustCompile(`^\s*(?:\[(\d{1,3})\]|(0\d))[\.\s_-]+`)
+
+	// A group with a hyphen of its own, or two groups joined by one: -DON-WiKi
+	collabGroupPattern = regexp.MustCompile(`-([A-Za-z0-9]+)-([A-Za-z0-9]+)$`)
+
+	// Suffix a reposter or obfuscating indexer appends to the release group
+	// (-NTb-postbot, -GROUP-Obfuscated); it names no group of its own
+	repostSuffixPattern = regexp.MustCompile(`(?i)-[A-Za-z0-9]+(-(?:Obfuscated|postbot|xpost|Scrambled|AsRequested))$`)
+
+	// Tag an indexer appends to the release group: -ROVERS[rartv]
+	indexerTagPattern = regexp.MustCompile(`-[A-Za-z0-9]+(\s?\[([A-Za-z0-9][\w\.]*)\])$`)
+
+	// PROPER/REPACK placed after the release group
+	trailingFlagPattern = regexp.MustCompile(`(?i)(-[a-zA-Z0-9]+)[\.\s-](PROPER|REPACK)$`)
+
+	// File size a scraper appended in brackets: (1.4GB), [2.3 GiB]. The unit is
+	// required, so channel layouts like (5.1) never match.
+	fileSizePattern = regexp.MustCompile(`(?i)[\.\s_-]*[\(\[]\s*(\d+(?:[\.,]\d+)?\s?[KMGT]i?B)\s*[\)\]]\s*$`)
+
 	// Tracker-specific patterns
-	btnSeasonPack     = regexp.MustCompile(`(?i)S(\d{1,2})[\.\s]?Complete`)
+	btnSeasonPack     = regexp.MustCompile(`(?i)\bS(\d{1,2})(?:[\.\s_]?-[\.\s_]?S(\d{1,2}))?[\.\s]?Complete`)
 	ptnYearRange      = regexp.MustCompile(`(\d{4})-(\d{4})`)
 	monoStereoPattern = regexp.MustCompile(`(?i)\b(Mono|Stereo)\b`)
 	channelPattern    = regexp.MustCompile(`(?i)\b(1\.0|2\.0|2\.1|3\.0|4\.0|5\.1|6\.0|6\.1|7\.0|7\.1|8\.1|9\.1|10\.2)\b`)
+
+	// Audio quality patterns, common in concert and music releases
+	audioBitratePattern    = regexp.MustCompile(`(?i)\b(\d{2,5})[\s]?kbps\b`)
+	audioBitDepthPattern   = regexp.MustCompile(`(?i)\b(16|24|32)[\s-]?bits?\b`)
+	audioSampleRatePattern = regexp.MustCompile(`(?i)\b(44\.1|48|88\.2|96|176\.4|192)[\s]?khz\b`)
 )
 
-// Parse analyzes a torrent name and extracts metadata
+// Parse analyzes a torrent name and extracts metadata using the default Parser.
+// Empty, whitespace-only and separator-only names yield a non-nil
+// TorrentInfo with an empty Title and zero Confidence.
 func Parse(name string) *TorrentInfo {
-	// Input validation
-	if name == "" {
-		return &TorrentInfo{
-			Title:      "",
-			Confidence: 0,
+	return defaultParser.Parse(name)
+}
+
+// Parse analyzes a torrent name and extracts metadata using the Parser's configuration
+func (p *Parser) Parse(name string) *TorrentInfo {
+	info := &TorrentInfo{}
+	p.parseInto(name, info)
+	return info
+}
+
+// ParseInto is Parse writing into dst, which is reset first, using the default
+// Parser. Reusing one TorrentInfo across calls saves an allocation per name on
+// hot paths; the result is identical to Parse.
+func ParseInto(name string, dst *TorrentInfo) {
+	defaultParser.ParseInto(name, dst)
+}
+
+// ParseInto is Parse writing into dst, which is reset first
+func (p *Parser) ParseInto(name string, dst *TorrentInfo) {
+	*dst = TorrentInfo{}
+	p.parseInto(name, dst)
+}
+
+// MetadataBoundary returns the byte index into name where the metadata following
+// the title begins, as determined by the default Parser. name[:index] holds the
+// title (plus trailing separators); a name without metadata returns len(name).
+// Metadata placed before the title (1080p.WEB-DL.Title...) does not count.
+func MetadataBoundary(name string) int {
+	return defaultParser.MetadataBoundary(name)
+}
+
+// MetadataBoundary returns the byte index into name where the metadata following
+// the title begins. See the package-level MetadataBoundary.
+func (p *Parser) MetadataBoundary(name string) int {
+	return p.parseInto(name, &TorrentInfo{})
+}
+
+// tvPatterns are the numbering forms that make a name a TV release: S01E01,
+// S01, Season 1, 1x01, a daily date and S01.Complete
+var tvPatterns = []*regexp.Regexp{
+	episodePattern, seasonPattern, seasonAltPattern, altEpisodePattern,
+	datePattern, btnSeasonPack,
+}
+
+// LooksLikeTV reports whether name carries TV numbering, without a full parse.
+// It is meant for routing names to a pipeline cheaply and agrees with the Kind
+// Parse sets for season, episode, daily and complete-series names, unnumbered
+// complete packs and numbered anime episode types (OVA 2, or SP1 in a fansub
+// name) included. Two forms are only read by Parse: a numbered Special or Movie
+// among the metadata of a name that isn't fansub-style (Show.1080p.Special.2),
+// and the numeric codes of WithNumericEpisodeCodes (Friends.101).
+func LooksLikeTV(name string) bool {
+	for _, pat := range tvPatterns {
+		if pat.MatchString(name) {
+			return true
 		}
 	}
+	// A numbered OVA is an episode anywhere, a numbered SP or Movie only in a
+	// fansub name (Scary.Movie.2 is a title)
+	if m := episodeTypePattern.FindStringSubmatch(name); m != nil && m[2] != "" {
+		return true
+	}
+	if animeStylePattern.MatchString(name) && numberedEpisodeTypePattern.MatchString(name) {
+		return true
+	}
+	if titledSpecials(name) {
+		return true
+	}
+	// A complete pack is a complete series when it says so, or when it has no
+	// numbering or year (The.Sopranos.Complete); Parse leaves a Complete that is
+	// not next to metadata in the title (A.Complete.Unknown)
+	loc := metadataWord(name, completePattern)
+	if loc == nil {
+		return false
+	}
+	if strings.Contains(strings.ToLower(name[loc[0]:loc[1]]), "series") {
+		return true
+	}
+	return loc[0] > 0 && !yearPattern.MatchString(name)
+}
 
-	info := &TorrentInfo{
-		Confidence: 1.0,
+// parseInto does the work for Parse, filling the zero TorrentInfo info and
+// returning the metadata boundary as an index into the original name
+func (p *Parser) parseInto(name string, info *TorrentInfo) int {
+	// Input validation: leaves an empty Title and zero Confidence
+	if isOnlySeparators(strings.TrimSpace(name)) {
+		return len(name)
+	}
+
+	// A scraped .torrent file name keeps the suffix after the container
+	if loc := torrentSuffixPattern.FindStringIndex(name); loc != nil && loc[0] > 0 {
+		name = name[:loc[0]]
+	}
+
+	// A name without separators is split at its glued tokens and parsed again
+	if spaced, offsets, ok := respaceGlued(name); ok {
+		boundary := offsets[p.parseInto(spaced, info)]
+		if info.RawTitle != "" {
+			info.RawTitle = strings.Trim(name[:boundary], ". -_")
+		}
+		return boundary
+	}
+
+	// Confidence is only ever set by calculateConfidence
+	original := name
+
+	// A scraped file size trails even the container
+	if m := fileSizePattern.FindStringSubmatchIndex(name); m != nil {
+		info.FileSize = name[m[2]:m[3]]
+		name = name[:m[0]]
+	}
+
+	// A subtitle file names its language before the extension; it is recorded
+	// after the scans, which treat a subtitle language as a duplicate
+	subtitleLanguage := ""
+	if m := subtitleFilePattern.FindStringSubmatchIndex(name); m != nil {
+		info.IsSubtitle = true
+		info.Container = strings.ToLower(name[m[4]:m[5]])
+		name = name[:m[4]-1]
+		if m[2] >= 0 {
+			if language := subtitleLanguageName(name[m[2]:m[3]]); language != "" {
+				subtitleLanguage = language
+				name = name[:m[0]]
+			}
+		}
 	}
 
 	// Extract container first (it's usually at the end)
@@ -104,42 +387,287 @@ func Parse(name string) *TorrentInfo {
 		name = name[:strings.LastIndex(name, last[0])]
 	}
 
+	// Padding separators after the last token would hide the release group
+	name = strings.TrimRight(name, ". -_")
+
+	// A reposter suffix would pass for the group, or half of a hyphenated one
+	if m := repostSuffixPattern.FindStringSubmatchIndex(name); m != nil {
+		name = name[:m[2]]
+	}
+
+	// An indexer tag would hide the release group it follows
+	if m := indexerTagPattern.FindStringSubmatchIndex(name); m != nil {
+		info.IndexerTag = name[m[4]:m[5]]
+		name = name[:m[2]]
+	}
+
+	// Underscores are word characters to the \b assertions in our patterns, so
+	// treat them as spaces (same width, so positions are unchanged)
+	name = strings.ReplaceAll(name, "_", " ")
+
+	// A no-break space is two bytes in UTF-8; two plain spaces keep positions too
+	name = strings.ReplaceAll(name, "\u00a0", "  ")
+
+	// Scene flags sometimes trail the group (-GROUP.PROPER); detach them so the
+	// group still anchors to the end of the name
+	for {
+		loc := trailingFlagPattern.FindStringSubmatchIndex(name)
+		if loc == nil {
+			break
+		}
+		if strings.EqualFold(name[loc[4]:loc[5]], "PROPER") {
+			info.IsProper = true
+		} else {
+			info.IsRepack = true
+		}
+		name = name[:loc[3]]
+	}
+
+	// Strip a known release group that isn't introduced by a hyphen, or a
+	// hyphenated one the group scan would split
+	name, knownGroup := p.stripKnownGroup(name)
+	if knownGroup == "" {
+		name, knownGroup = p.stripCollabGroup(name)
+	}
+
+	// A list index is blanked out (keeping positions) when a title follows it
+	titleStart := 0
+	if p.listIndex {
+		if m := listIndexPattern.FindStringSubmatchIndex(name); m != nil && startsWithLetter(name[m[1]:]) {
+			digits := m[2:4]
+			if digits[0] < 0 {
+				digits = m[4:6]
+			}
+			info.ListIndex, _ = strconv.Atoi(name[digits[0]:digits[1]])
+			name = blankSpans(name, []textSpan{{0, m[1]}})
+			titleStart = m[1]
+		}
+	}
+
+	// A remaster year is blanked out (keeping positions) so the year next to the
+	// title stays the release year
+	if m := remasterYearPattern.FindStringSubmatchIndex(name); m != nil {
+		if year, err := strconv.Atoi(name[m[2]:m[3]]); err == nil && p.isReleaseYear(year) {
+			info.RemasterYear = year
+			name = blankSpans(name, []textSpan{{m[2], m[3]}})
+		}
+	}
+
+	// Three or more rising years after the title (Collection.2018.2019.2020)
+	// span a pack; a run that starts the name is a year-like title instead
+	name = p.extractYearRun(name, info)
+
 	// Extract date early for daily shows (but not year - let metadata boundary detection handle it)
-	if match := datePattern.FindString(name); match != "" {
+	dateStart, dateLen := -1, 0
+	if loc := datePattern.FindStringIndex(name); loc != nil {
+		match := name[loc[0]:loc[1]]
 		info.Date = strings.ReplaceAll(match, "-", ".")
-		if year, err := strconv.Atoi(match[:4]); err == nil && year >= 1895 && year <= time.Now().Year() {
+		if year, err := strconv.Atoi(match[:4]); err == nil && p.isReleaseYear(year) {
 			info.Year = year
 		}
-		name = strings.Replace(name, match, "", 1)
+		name = name[:loc[0]] + name[loc[1]:]
+		dateStart, dateLen = loc[0], len(match)
+	}
+	dateless := name
+
+	// Leading metadata (1080p.WEB-DL.The.Office...) is parsed on its own so the
+	// title boundary is found in what follows it
+	prefixEnd := leadingMetadataEnd(name)
+	if prefixEnd > 0 {
+		p.scanDefiniteMetadata(name[:prefixEnd], info, prefixEnd)
+		name = name[prefixEnd:]
 	}
 
 	// Find metadata boundary using three-phase approach
-	metadataStartPos := findMetadataBoundary(name, info)
+	metadataStartPos, episodeTitle := p.findMetadataBoundary(name, info)
+
+	// Map the boundary back to the original name: undo the prefix cut, then the
+	// date removal. A date right at the boundary is where the metadata begins.
+	boundary := metadataStartPos + prefixEnd
+	if dateStart >= 0 && boundary >= dateStart {
+		if isOnlySeparators(dateless[dateStart:boundary]) {
+			boundary = dateStart
+		} else {
+			boundary += dateLen
+		}
+	}
+
+	// Groups stripped before the scans stand; known ones take their configured spelling
+	if knownGroup != "" {
+		info.ReleaseGroup = knownGroup
+	} else if group, ok := p.groups[strings.ToUpper(info.ReleaseGroup)]; ok {
+		info.ReleaseGroup = group
+	}
 
 	// Extract title using the metadata start position
 	info.Title = extractTitleFromPosition(name, metadataStartPos)
+	if info.HasSeason || info.Episode != 0 || info.Date != "" {
+		info.Title, info.Country = splitCountry(info.Title)
+	}
+	if p.rawTitle && info.Title != "" {
+		info.RawTitle = strings.Trim(original[max(prefixEnd, titleStart):boundary], ". -_")
+	}
+
+	// A bare WEB is resolved by hint tokens after the title only, so a title
+	// word (Rip.Tide, Hulu.Originals) says nothing about the source
+	if info.Source == "WEB" {
+		info.Source = webSourceName(name[metadataStartPos:])
+	}
+
+	// A frame size only stands in for a missing resolution token
+	if info.Resolution == "" {
+		if match := dimensionsPattern.FindString(name[metadataStartPos:]); match != "" {
+			info.Resolution = dimensionsResolution(match)
+		}
+	}
+
+	// A complete pack covering a season range is a complete series, and so is
+	// one with no numbering at all (The.Sopranos.Complete). A release year
+	// leaves it a movie (Movie.2010.Complete)
+	if info.IsComplete && info.SeasonEnd > info.Season {
+		info.IsCompleteSeries = true
+	}
+	if info.IsComplete && !info.HasSeason && info.Episode == 0 && info.Date == "" && info.Year == 0 {
+		info.IsCompleteSeries = true
+	}
+
+	// A season without an episode or air date is a pack, keyword or not
+	info.IsSeasonPack = info.HasSeason && info.Episode == 0 && info.Date == ""
+	info.setKind()
+
+	// Leftover words (everything after metadata start that isn't metadata) are
+	// the episode title of an episode; elsewhere they go to Unparsed, and they
+	// weigh on the title's confidence whether or not Unparsed is filled
+	var leftover string
+	if info.Kind == KindEpisode {
+		info.EpisodeTitle = p.leftover(name, metadataStartPos, episodeTitle)
+	} else {
+		leftover = p.leftover(name, metadataStartPos, episodeTitle)
+		if p.unparsed {
+			info.Unparsed = leftover
+		}
+	}
+
+	// A stereoscopic layout implies 3D even without the 3D tag
+	if info.ThreeDLayout != "" {
+		info.Is3D = true
+	}
+
+	// Regular numbered episodes default to the plain episode type
+	if info.EpisodeType == "" && info.Episode != 0 {
+		info.EpisodeType = "Episode"
+	}
+
+	// MULTi.SUBS means multiple subtitle tracks rather than multiple languages
+	if len(info.Subtitles) > 0 && multiSubsPattern.MatchString(name[metadataStartPos:]) {
+		info.moveMultiToSubtitles()
+	}
+
+	// Collect language codes attached to the audio tracks
+	info.AudioLanguages, _ = extractAudioLanguages(name, metadataStartPos)
+
+	if subtitleLanguage != "" && !slices.Contains(info.Subtitles, subtitleLanguage) {
+		if len(info.Subtitles) == 1 && info.Subtitles[0] == "Unknown" {
+			// The file names the language a bare SUBS tag left open
+			info.Subtitles = info.Subtitles[:0]
+		}
+		info.Subtitles = append(info.Subtitles, subtitleLanguage)
+	}
 
-	// Extract unparsed content (everything after metadata start that isn't metadata)
-	info.Unparsed = extractUnparsedContent(name, metadataStartPos)
+	// Derive convenience flags from the normalized resolution
+	info.setResolutionFlags()
 
 	// Calculate confidence based on what we found
-	info.calculateConfidence()
+	info.calculateConfidence(p.weights)
+	info.setFieldConfidence(name, leftover != "")
 
-	return info
+	return boundary
+}
+
+// leftover returns the words after metadataStartPos that aren't metadata. An
+// episode title span is kept verbatim, metadata-like words and all.
+func (p *Parser) leftover(name string, metadataStartPos int, episodeTitle textSpan) string {
+	var extra []*regexp.Regexp
+	if p.numericEpisodeCodes {
+		extra = append(extra, numericEpisodePattern)
+	}
+	if episodeTitle.empty() {
+		return extractUnparsedContent(name, metadataStartPos, extra...)
+	}
+	return NormalizeWhitespace(strings.Join([]string{
+		extractUnparsedContent(name[:episodeTitle.start], metadataStartPos, extra...),
+		separatorReplacer.Replace(name[episodeTitle.start:episodeTitle.end]),
+		extractUnparsedContent(name, episodeTitle.end, extra...),
+	}, " "))
 }
 
-// findMetadataBoundary finds all metadata and determines where the title ends
-func findMetadataBoundary(name string, info *TorrentInfo) int {
+// settleByRank handles a repeated resolution, source or codec token when the
+// Parser has a quality precedence: the better-ranked value is kept and the other
+// token returned for Ignored. ok is false for other tokens, or without a
+// precedence, so the duplicate ends the scan as usual.
+func (p *Parser) settleByRank(pattern *regexp.Regexp, token string, info *TorrentInfo) (loser string, ok bool) {
+	if p.precedence == nil {
+		return "", false
+	}
+	ranks := p.precedence
+	switch pattern {
+	case resolutionPattern:
+		submatch := resolutionPattern.FindStringSubmatch(token)
+		resolution := resolutionName(submatch[1])
+		if ranks.Resolution[resolution] <= ranks.Resolution[info.Resolution] {
+			return token, true
+		}
+		loser = info.Resolution
+		info.Resolution = resolution
+		rate, _ := strconv.ParseFloat(submatch[2], 64)
+		info.FrameRate = int(math.Round(rate))
+		return loser, true
+	case sourcePattern:
+		source := sourceName(token)
+		if ranks.Source[source] <= ranks.Source[info.Source] {
+			return token, true
+		}
+		loser = info.SourceRaw
+		info.Source, info.SourceRaw = source, token
+		return loser, true
+	case codecPattern:
+		codec, encoder := codecName(token)
+		if ranks.Codec[codec] <= ranks.Codec[info.Codec] {
+			return token, true
+		}
+		loser = info.CodecRaw
+		info.Codec, info.EncoderCodec, info.CodecRaw = codec, encoder, token
+		return loser, true
+	}
+	return "", false
+}
+
+// findMetadataBoundary finds all metadata and determines where the title ends.
+// It also returns the span of an episode title that holds metadata-like words
+// (see scanDefiniteMetadata and titleExtraWords), or an empty span.
+func (p *Parser) findMetadataBoundary(name string, info *TorrentInfo) (int, textSpan) {
 	metadataStartPos := len(name)
 
 	// Phase 1: Definite metadata (back-to-front)
-	metadataStartPos = scanDefiniteMetadata(name, info, metadataStartPos)
+	metadataStartPos, episodeTitle := p.scanDefiniteMetadata(name, info, metadataStartPos)
 
-	// Phase 2: Possible metadata phase 1 (back-to-front, up to current metadata start)
-	metadataStartPos = scanPossibleMetadataPhase1(name, info, metadataStartPos)
+	// Phase 2: Possible metadata phase 1 (back-to-front, up to current metadata start).
+	// An episode title is words and codes after an audio token name its tracks,
+	// so both are blanked out (keeping positions) first.
+	_, hidden := extractAudioLanguages(name, metadataStartPos)
+	if !episodeTitle.empty() {
+		hidden = append(hidden, episodeTitle)
+	}
+	// A bare extra word among other words is kept as words too
+	extras := titleExtraWords(name, metadataStartPos)
+	hidden = append(hidden, extras...)
+	if episodeTitle.empty() && len(extras) > 0 {
+		episodeTitle = extras[0]
+	}
+	metadataStartPos = p.scanPossibleMetadataPhase1(blankSpans(name, hidden), info, metadataStartPos)
 
 	// Phase 3: Possible metadata phase 2 (front-to-back, from current metadata start)
-	metadataStartPos = scanPossibleMetadataPhase2(name, info, metadataStartPos)
+	metadataStartPos = p.scanPossibleMetadataPhase2(name, info, metadataStartPos)
 
 	// Final validation - this should never happen if parsing logic is correct
 	if metadataStartPos < 0 {
@@ -149,11 +677,147 @@ func findMetadataBoundary(name string, info *TorrentInfo) int {
 		panic("final metadata start position exceeds string length - parsing logic error")
 	}
 
-	return metadataStartPos
+	return metadataStartPos, episodeTitle
+}
+
+// textSpan is the byte range [start, end) of a name
+type textSpan struct {
+	start, end int
+}
+
+// empty reports whether the span covers no text
+func (s textSpan) empty() bool {
+	return s.end <= s.start
 }
 
-// scanDefiniteMetadata scans for definite metadata from back to front
-func scanDefiniteMetadata(name string, info *TorrentInfo, startPos int) int {
+// extractYearRun sets YearEnd from a run of three or more rising release years
+// after the title and blanks all but the first year, keeping positions, so the
+// scans take that one as Year. Other names are returned unchanged.
+func (p *Parser) extractYearRun(name string, info *TorrentInfo) string {
+	loc := yearRunPattern.FindStringIndex(name)
+	if loc == nil || loc[0] == 0 {
+		return name
+	}
+	years := yearPattern.FindAllStringIndex(name[loc[0]:loc[1]], -1)
+	prev := 0
+	for _, y := range years {
+		year, _ := strconv.Atoi(name[loc[0]+y[0] : loc[0]+y[1]])
+		if !p.isReleaseYear(year) || year <= prev {
+			return name
+		}
+		prev = year
+	}
+	info.YearEnd = prev
+	return blankSpans(name, []textSpan{{loc[0] + years[0][1], loc[1]}})
+}
+
+// titledSpecials reports whether name has a Specials that Parse reads as
+// season 0: next to metadata and after a title (Doctor.Who.Specials.2013), not
+// after a lone article (The.Specials.2019) or among title words (Big.Specials.Night)
+func titledSpecials(name string) bool {
+	loc := metadataWord(name, specialsPattern)
+	return loc != nil && hasTitle(name[:loc[0]])
+}
+
+// hasTitle reports whether text has words other than stopwords
+func hasTitle(text string) bool {
+	for _, word := range strings.Fields(separatorReplacer.Replace(text)) {
+		if !englishStopwords[strings.ToLower(word)] {
+			return true
+		}
+	}
+	return false
+}
+
+// titleExtraWords returns the spans of bare extra words (Bonus, Trailer, Extras)
+// after start that have a word other than metadata next to them, as in an
+// episode title (Jeopardy.S01E03.Bonus.Round)
+func titleExtraWords(name string, start int) []textSpan {
+	var spans []textSpan
+	for _, loc := range bareExtraPattern.FindAllStringIndex(name[start:], -1) {
+		wordStart, wordEnd := start+loc[0], start+loc[1]
+		before := name[start:wordStart]
+		before = before[strings.LastIndexAny(strings.TrimRight(before, ". "), ". ")+1:]
+		if extractUnparsedContent(before, 0) != "" || extractUnparsedContent(wordAfter(name, wordEnd), 0) != "" {
+			spans = append(spans, textSpan{wordStart, wordEnd})
+		}
+	}
+	return spans
+}
+
+// wordAfter returns the word following end in name, or "" at the end of the name
+func wordAfter(name string, end int) string {
+	after := strings.TrimLeft(name[end:], ". ")
+	if i := strings.IndexAny(after, ". "); i >= 0 {
+		after = after[:i]
+	}
+	return after
+}
+
+// metadataWord returns the location of the first match of pattern in name that
+// ends the name or is followed by metadata, as the scans only read such a word
+// there; elsewhere it is part of the title (A.Complete.Unknown.1080p)
+func metadataWord(name string, pattern *regexp.Regexp) []int {
+	for _, loc := range pattern.FindAllStringIndex(name, -1) {
+		if extractUnparsedContent(wordAfter(name, loc[1]), 0) == "" {
+			return loc
+		}
+	}
+	return nil
+}
+
+// blankSpans replaces the text of spans in name with spaces, keeping positions
+func blankSpans(name string, spans []textSpan) string {
+	if len(spans) == 0 {
+		return name
+	}
+	b := []byte(name)
+	for _, s := range spans {
+		for i := s.start; i < s.end; i++ {
+			b[i] = ' '
+		}
+	}
+	return string(b)
+}
+
+// separatorReplacer turns name separators into spaces
+var separatorReplacer = strings.NewReplacer(".", " ", "_", " ", "-", " ")
+
+// separatorRemover drops the separators inside a dotted codec token (H.264)
+var separatorRemover = strings.NewReplacer(".", "", " ", "")
+
+// scanMatch is a pattern match found by a scan phase
+type scanMatch struct {
+	start, end int
+	pattern    int // index into the phase's patterns
+}
+
+// matchesPool recycles the scan phases' match buffers between parses
+var matchesPool = sync.Pool{
+	New: func() any {
+		matches := make([]scanMatch, 0, 32)
+		return &matches
+	},
+}
+
+// getMatches takes an empty match buffer from the pool
+func getMatches() *[]scanMatch {
+	return matchesPool.Get().(*[]scanMatch)
+}
+
+// putMatches returns a match buffer to the pool
+func putMatches(buf *[]scanMatch) {
+	*buf = (*buf)[:0]
+	matchesPool.Put(buf)
+}
+
+// scanDefiniteMetadata scans for definite metadata from back to front.
+//
+// A duplicate normally ends the scan, but one that follows an episode code
+// (S01E05.4K.Restoration.1080p) is taken to be part of the episode title: the
+// text from the end of the episode code up to the metadata found so far is
+// returned as the episode title span and the scan resumes at the episode code.
+func (p *Parser) scanDefiniteMetadata(name string, info *TorrentInfo, startPos int) (int, textSpan) {
 	// Validate input - startPos should be the string length initially
 	if startPos != len(name) {
 		panic("scanDefiniteMetadata: startPos should equal string length - parsing logic error")
@@ -168,83 +832,123 @@ func scanDefiniteMetadata(name string, info *TorrentInfo, startPos int) int {
 	}{
 		{resolutionPattern, func(match string, info *TorrentInfo) bool {
 			if info.Resolution == "" {
-				info.Resolution = strings.ToLower(match)
-				if info.Resolution == "4k" {
-					info.Resolution = "2160p"
+				submatch := resolutionPattern.FindStringSubmatch(match)
+				info.Resolution = resolutionName(submatch[1])
+				if submatch[2] != "" {
+					rate, _ := strconv.ParseFloat(submatch[2], 64)
+					info.FrameRate = int(math.Round(rate))
 				}
 				return true
 			}
 			return false
 		}},
+		{dimensionsPattern, func(match string, info *TorrentInfo) bool {
+			// Read after the scan, once it is known whether a resolution token is present
+			return true
+		}},
 		{sourcePattern, func(match string, info *TorrentInfo) bool {
-			if info.Source == "" {
-				source := match
-				// Normalize source names
-				switch strings.ToUpper(source) {
-				case "BLURAY", "BLU-RAY":
-					info.Source = "BluRay"
-				case "WEB-DL", "WEBDL":
-					info.Source = "WEB-DL"
-				case "WEBRIP", "WEB":
-					info.Source = "WEBRip"
-				default:
-					info.Source = strings.ToUpper(source)
+			upper := strings.ToUpper(match)
+			isRemux := upper == "REMUX" || upper == "BDREMUX"
+			// A remux names its disc source too (BluRay.REMUX); the pair is one REMUX source
+			if (info.Source == "REMUX" && (upper == "BLURAY" || upper == "BLU-RAY")) || (info.Source == "BluRay" && isRemux) {
+				info.Source = "REMUX"
+				if isRemux {
+					info.SourceRaw = match
 				}
 				return true
 			}
+			if info.Source == "" {
+				info.Source = sourceName(match)
+				info.SourceRaw = match
+				return true
+			}
 			return false
 		}},
 		{codecPattern, func(match string, info *TorrentInfo) bool {
 			if info.Codec == "" {
-				codec := strings.ToUpper(match)
-				// Normalize codec names
-				switch codec {
-				case "H264", "X264", "AVC":
-					info.Codec = "H264"
-				case "H265", "X265", "HEVC":
-					info.Codec = "H265"
-				default:
-					info.Codec = codec
-				}
+				info.Codec, info.EncoderCodec = codecName(match)
+				info.CodecRaw = match
+				return true
+			}
+			return false
+		}},
+		{discTypePattern, func(match string, info *TorrentInfo) bool {
+			if info.DiscType == "" {
+				info.DiscType = strings.ToUpper(match)
 				return true
 			}
 			return false
 		}},
 		{episodePattern, func(match string, info *TorrentInfo) bool {
 			if info.Episode == 0 {
-				// Extract season from the same pattern
-				if seasonMatch := seasonPattern.FindStringSubmatch(match); seasonMatch != nil {
-					info.Season, _ = strconv.Atoi(seasonMatch[1])
+				// Season and episode come from the same match (S01E01, s01e01, S1.E1)
+				if submatch := episodePattern.FindStringSubmatch(match); submatch != nil {
+					info.Season, _ = strconv.Atoi(submatch[1])
+					info.HasSeason = true
+					info.Episode, _ = strconv.Atoi(submatch[2])
+					// Episode range (S01E01-E10)
+					if submatch[3] != "" {
+						info.EpisodeEnd, _ = strconv.Atoi(submatch[3])
+						if info.EpisodeEnd >= info.Episode {
+							info.EpisodeCount = info.EpisodeEnd - info.Episode + 1
+						}
+					}
+					return true
 				}
-				ep, _ := strconv.Atoi(match[strings.LastIndex(match, "E")+1:])
-				info.Episode = ep
-				return true
 			}
 			return false
 		}},
 		{altEpisodePattern, func(match string, info *TorrentInfo) bool {
 			if info.Episode == 0 {
-				parts := strings.Split(match, "x")
-				if len(parts) == 2 {
-					info.Season, _ = strconv.Atoi(parts[0])
-					ep, _ := strconv.Atoi(parts[1])
-					info.Episode = ep
+				// 1x01 and 01X01 forms
+				if submatch := altEpisodePattern.FindStringSubmatch(match); submatch != nil {
+					info.Season, _ = strconv.Atoi(submatch[1])
+					info.HasSeason = true
+					info.Episode, _ = strconv.Atoi(submatch[2])
+					return true
+				}
+			}
+			return false
+		}},
+		{episodeTypePattern, func(match string, info *TorrentInfo) bool {
+			// Anime sub-types OVA, ONA and OAD, number optional
+			return info.setEpisodeType(episodeTypePattern, match)
+		}},
+		{episodeCountPattern, func(match string, info *TorrentInfo) bool {
+			if info.EpisodeCount == 0 {
+				// Textual episode count (10 Episodes)
+				if submatch := episodeCountPattern.FindStringSubmatch(match); submatch != nil {
+					info.EpisodeCount, _ = strconv.Atoi(submatch[1])
 					return true
 				}
 			}
 			return false
 		}},
 		{seasonPattern, func(match string, info *TorrentInfo) bool {
-			if info.Season == 0 {
-				info.Season, _ = strconv.Atoi(match[1:])
-				return true
+			if !info.HasSeason {
+				// Single season (S01) or season range (S01-S05)
+				if submatch := seasonPattern.FindStringSubmatch(match); submatch != nil {
+					info.Season, _ = strconv.Atoi(submatch[1])
+					info.HasSeason = true
+					if submatch[2] != "" {
+						info.SeasonEnd, _ = strconv.Atoi(submatch[2])
+					}
+					return true
+				}
 			}
 			return false
 		}},
 		{seasonAltPattern, func(match string, info *TorrentInfo) bool {
-			if info.Season == 0 {
-				info.Season, _ = strconv.Atoi(match[strings.Index(match, "n")+1:])
-				return true
+			if !info.HasSeason {
+				// Single season (Season 1) or season range (Seasons 1-5, Season 1 to 3)
+				if submatch := seasonAltPattern.FindStringSubmatch(match); submatch != nil {
+					info.Season, _ = strconv.Atoi(submatch[1])
+					info.HasSeason = true
+					if submatch[2] != "" {
+						info.SeasonEnd, _ = strconv.Atoi(submatch[2])
+					}
+					return true
+				}
 			}
 			return false
 		}},
@@ -253,7 +957,7 @@ func scanDefiniteMetadata(name string, info *TorrentInfo, startPos int) int {
 				// Store the full date (YYYY.MM.DD format)
 				info.Date = strings.ReplaceAll(match, "-", ".")
 				// Also set the year for compatibility
-				if year, err := strconv.Atoi(match[:4]); err == nil && year >= 1895 && year <= time.Now().Year() {
+				if year, err := strconv.Atoi(match[:4]); err == nil && p.isReleaseYear(year) {
 					info.Year = year
 				}
 				return true
@@ -261,9 +965,13 @@ func scanDefiniteMetadata(name string, info *TorrentInfo, startPos int) int {
 			return false
 		}},
 		{btnSeasonPack, func(match string, info *TorrentInfo) bool {
-			if info.Season == 0 && !info.IsComplete {
+			if !info.HasSeason && !info.IsComplete {
 				if submatch := btnSeasonPack.FindStringSubmatch(match); submatch != nil {
 					info.Season, _ = strconv.Atoi(submatch[1])
+					info.HasSeason = true
+					if submatch[2] != "" {
+						info.SeasonEnd, _ = strconv.Atoi(submatch[2])
+					}
 					info.IsComplete = true
 					return true
 				}
@@ -272,21 +980,29 @@ func scanDefiniteMetadata(name string, info *TorrentInfo, startPos int) int {
 		}},
 	}
 
-	// Find all matches and sort by position (descending for back-to-front scan)
-	var matches []struct {
-		start, end int
-		pattern    int
+	// Numbered specials and movies only count in anime-style names here; in
+	// others Phase 1 reads them after the title
+	if animeStylePattern.MatchString(name) {
+		patterns = append(patterns, struct {
+			pattern *regexp.Regexp
+			handler func(string, *TorrentInfo) bool
+		}{numberedEpisodeTypePattern, func(match string, info *TorrentInfo) bool {
+			return info.setEpisodeType(numberedEpisodeTypePattern, match)
+		}})
 	}
 
+	// Find all matches and sort by position (descending for back-to-front scan)
+	buf := getMatches()
+	defer putMatches(buf)
+	matches := (*buf)[:0]
+
 	for i, p := range patterns {
 		allMatches := p.pattern.FindAllStringIndex(name, -1)
 		for _, match := range allMatches {
-			matches = append(matches, struct {
-				start, end int
-				pattern    int
-			}{match[0], match[1], i})
+			matches = append(matches, scanMatch{match[0], match[1], i})
 		}
 	}
+	*buf = matches
 
 	// Sort by start position (descending for back-to-front scan)
 	for i := 0; i < len(matches); i++ {
@@ -297,8 +1013,22 @@ func scanDefiniteMetadata(name string, info *TorrentInfo, startPos int) int {
 		}
 	}
 
+	// episodeCodeEnd returns the end of the nearest episode code (S01E05, 1x05)
+	// among the remaining matches that ends at or before pos, or -1
+	episodeCodeEnd := func(rest []scanMatch, pos int) int {
+		for _, m := range rest {
+			pattern := patterns[m.pattern].pattern
+			if m.end <= pos && (pattern == episodePattern || pattern == altEpisodePattern) {
+				return m.end
+			}
+		}
+		return -1
+	}
+
 	// Process matches from end to beginning
-	for _, match := range matches {
+	var episodeTitle textSpan
+	var displaced []string
+	for i, match := range matches {
 		if match.start >= metadataStartPos {
 			continue // Skip if already past our metadata start
 		}
@@ -311,22 +1041,46 @@ func scanDefiniteMetadata(name string, info *TorrentInfo, startPos int) int {
 				panic("scanDefiniteMetadata: metadata start position increased - parsing logic error")
 			}
 			metadataStartPos = match.start
+		} else if loser, ok := p.settleByRank(patterns[match.pattern].pattern, matchText, info); ok {
+			// A repeated resolution, source or codec ranked against the first
+			displaced = append(displaced, loser)
+			metadataStartPos = match.start
+		} else if code := episodeCodeEnd(matches[i+1:], match.start); episodeTitle.empty() && code >= 0 {
+			// The duplicate sits in an episode title; skip to the episode code
+			episodeTitle = textSpan{code, metadataStartPos}
+			metadataStartPos = code
 		} else {
-			// Duplicate metadata found, terminate scan
+			// Duplicate metadata found, terminate scan. The rest of the scan is
+			// replayed on a scratch copy to report every duplicate in the name.
+			scratch := *info
+			var ignored []string
+			replayStart := metadataStartPos
+			for _, rest := range matches[i:] {
+				if rest.start >= replayStart {
+					continue
+				}
+				restText := name[rest.start:rest.end]
+				if !patterns[rest.pattern].handler(restText, &scratch) {
+					ignored = append(ignored, restText)
+				}
+				replayStart = rest.start
+			}
+			info.addIgnored(ignored)
 			break
 		}
 	}
+	info.addIgnored(displaced)
 
 	// Final validation - metadata start should never be negative
 	if metadataStartPos < 0 {
 		panic("scanDefiniteMetadata: final metadata start position is negative - parsing logic error")
 	}
 
-	return metadataStartPos
+	return metadataStartPos, episodeTitle
 }
 
 // scanPossibleMetadataPhase1 scans for possible metadata from back to front, up to current metadata start
-func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) int {
+func (p *Parser) scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) int {
 	metadataStartPos := startPos
 
 	// Validate metadata boundary position - this should never happen if parsing logic is correct
@@ -350,7 +1104,7 @@ func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) in
 	}{
 		{yearPattern, func(match string, info *TorrentInfo) bool {
 			if info.Year == 0 {
-				if year, err := strconv.Atoi(match); err == nil && year >= 1895 && year <= time.Now().Year() {
+				if year, err := strconv.Atoi(match); err == nil && p.isReleaseYear(year) {
 					info.Year = year
 					return true
 				}
@@ -359,9 +1113,22 @@ func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) in
 		}, false},
 		{editionPattern, func(match string, info *TorrentInfo) bool {
 			if info.Edition == "" {
-				// Normalize multi-word editions by replacing dots with spaces
-				norm := strings.ReplaceAll(match, ".", " ")
-				info.Edition = strings.Title(strings.ToLower(norm))
+				info.Edition = editionName(match)
+				return true
+			}
+			return false
+		}, false},
+		{dcEditionPattern, func(match string, info *TorrentInfo) bool {
+			if info.Edition == "" {
+				info.Edition = editionName(match)
+				return true
+			}
+			return false
+		}, false},
+		{specialsPattern, func(match string, info *TorrentInfo) bool {
+			if !info.HasSeason && info.Episode == 0 {
+				info.Season = 0
+				info.HasSeason = true
 				return true
 			}
 			return false
@@ -369,6 +1136,35 @@ func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) in
 		{completePattern, func(match string, info *TorrentInfo) bool {
 			if !info.IsComplete {
 				info.IsComplete = true
+				if strings.Contains(strings.ToLower(match), "series") {
+					info.IsCompleteSeries = true
+				}
+				return true
+			}
+			return false
+		}, false},
+		{qualitativeResolutionPattern, func(match string, info *TorrentInfo) bool {
+			// A line count elsewhere in the name (2160p.UHD.BluRay) wins
+			if info.Resolution == "" {
+				info.Resolution = qualitativeResolutions[strings.ToUpper(match)]
+				return true
+			}
+			return false
+		}, false},
+		{hdrPattern, func(match string, info *TorrentInfo) bool {
+			return info.addHDR(match)
+		}, false},
+		{miniseriesPattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsMiniseries {
+				info.IsMiniseries = true
+				return true
+			}
+			return false
+		}, false},
+		{extraPattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsExtra {
+				info.IsExtra = true
+				info.ExtraType = extraName(match)
 				return true
 			}
 			return false
@@ -394,17 +1190,77 @@ func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) in
 			}
 			return false
 		}, false},
-		{languagePattern, func(match string, info *TorrentInfo) bool {
-			if info.Language == "" {
-				info.Language = strings.Title(strings.ToLower(match))
+		{dubbedPattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsDubbed {
+				info.IsDubbed = true
+				return true
+			}
+			return false
+		}, false},
+		{sceneTagPattern, func(match string, info *TorrentInfo) bool {
+			return info.addSceneTag(match)
+		}, false},
+		{audioBitratePattern, func(match string, info *TorrentInfo) bool {
+			if info.AudioBitrate == "" {
+				info.AudioBitrate = audioBitratePattern.FindStringSubmatch(match)[1] + "Kbps"
+				return true
+			}
+			return false
+		}, false},
+		{audioBitDepthPattern, func(match string, info *TorrentInfo) bool {
+			if info.AudioBitDepth == "" {
+				info.AudioBitDepth = audioBitDepthPattern.FindStringSubmatch(match)[1] + "bit"
+				return true
+			}
+			return false
+		}, false},
+		{audioSampleRatePattern, func(match string, info *TorrentInfo) bool {
+			if info.AudioSampleRate == "" {
+				info.AudioSampleRate = audioSampleRatePattern.FindStringSubmatch(match)[1] + "kHz"
+				return true
+			}
+			return false
+		}, false},
+		{volumePattern, func(match string, info *TorrentInfo) bool {
+			if info.Volume == 0 {
+				info.Volume, _ = strconv.Atoi(volumePattern.FindStringSubmatch(match)[1])
+				return true
+			}
+			return false
+		}, false},
+		{partPattern, func(match string, info *TorrentInfo) bool {
+			if info.Part == 0 {
+				info.Part = partNumber(match)
+				return true
+			}
+			return false
+		}, false},
+		{threeDPattern, func(match string, info *TorrentInfo) bool {
+			if !info.Is3D {
+				info.Is3D = true
+				return true
+			}
+			return false
+		}, false},
+		{threeDLayoutPattern, func(match string, info *TorrentInfo) bool {
+			if info.ThreeDLayout == "" {
+				info.ThreeDLayout = threeDLayoutName(match)
 				return true
 			}
 			return false
 		}, false},
+		{languagePattern, func(match string, info *TorrentInfo) bool {
+			info.addLanguage(match)
+			return true
+		}, false},
+		{languageCodePattern, func(match string, info *TorrentInfo) bool {
+			info.addLanguage(match)
+			return true
+		}, false},
 		{subsPattern, func(match string, info *TorrentInfo) bool {
 			if len(info.Subtitles) == 0 {
 				// Try to find specific subtitle languages
-				subLanguages := regexp.MustCompile(`(?i)(ENG|FRE|SPA|GER|ITA|DAN|DUT|JAP|CHI|RUS|POL|VIE|SWE|NOR|FIN|TUR|POR|KOR)[\.\s]?SUBS`).FindAllStringSubmatch(match, -1)
+				subLanguages := subLanguagePattern.FindAllStringSubmatch(match, -1)
 				for _, submatch := range subLanguages {
 					info.Subtitles = append(info.Subtitles, submatch[1])
 				}
@@ -421,7 +1277,7 @@ func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) in
 			if info.ReleaseGroup == "" {
 				if submatch := releaseGroupPattern.FindStringSubmatch(match); submatch != nil {
 					group := submatch[1]
-					if !isQualityTag(group) && len(group) >= 2 {
+					if p.isReleaseGroup(group) {
 						info.ReleaseGroup = group
 						return true
 					}
@@ -441,27 +1297,40 @@ func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) in
 			// audioTokens handled outside
 			return true
 		}, true},
-		{regexp.MustCompile(`(?i)\b(ATMOS|DTS-X|DTS-HD|DTS-HD MA|DTS-ES|DD\+|DD|EAC3)\b`), func(match string, info *TorrentInfo) bool {
+		{audioExtraPattern, func(match string, info *TorrentInfo) bool {
+			// audioTokens handled outside
+			return true
+		}, true},
+		{audioGluedPattern, func(match string, info *TorrentInfo) bool {
 			// audioTokens handled outside
 			return true
 		}, true},
 	}
 
-	// Find all matches and sort by position (descending for back-to-front scan)
-	var matches []struct {
-		start, end int
-		pattern    int
+	// Numbered specials and movies past the title (Show.S02.Special.1); in
+	// anime-style names the definite scan has read them already
+	if !animeStylePattern.MatchString(name) {
+		patterns = append(patterns, struct {
+			pattern *regexp.Regexp
+			handler func(string, *TorrentInfo) bool
+			isAudio bool
+		}{numberedEpisodeTypePattern, func(match string, info *TorrentInfo) bool {
+			return info.setEpisodeType(numberedEpisodeTypePattern, match)
+		}, false})
 	}
 
+	// Find all matches and sort by position (descending for back-to-front scan)
+	buf := getMatches()
+	defer putMatches(buf)
+	matches := (*buf)[:0]
+
 	for i, p := range patterns {
 		allMatches := p.pattern.FindAllStringIndex(name, -1)
 		for _, match := range allMatches {
-			matches = append(matches, struct {
-				start, end int
-				pattern    int
-			}{match[0], match[1], i})
+			matches = append(matches, scanMatch{match[0], match[1], i})
 		}
 	}
+	*buf = matches
 
 	// Sort by start position (descending for back-to-front scan)
 	for i := 0; i < len(matches); i++ {
@@ -473,19 +1342,37 @@ func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) in
 	}
 
 	// Process matches from end to beginning, up to current metadata start
-	for _, match := range matches {
+	for i, match := range matches {
 		if match.start < metadataStartPos {
 			break // Skip if before our metadata start - all subsequent matches will also be before
 		}
 
 		matchText := name[match.start:match.end]
 		if patterns[match.pattern].isAudio {
-			audioTokens = append(audioTokens, strings.ToUpper(matchText))
+			audioTokens = append(audioTokens, audioToken(matchText))
 		}
 		if patterns[match.pattern].handler(matchText, info) {
 			// New metadata found, but don't update start position in step 2
 		} else {
-			// Duplicate metadata found, terminate scan
+			// Duplicate metadata found, terminate scan. The rest of the scan is
+			// replayed on a scratch copy to report every duplicate in the name.
+			scratch := *info
+			var ignored []string
+			replayStart := len(name) + 1
+			for _, rest := range matches[i:] {
+				if rest.start < metadataStartPos {
+					break
+				}
+				if rest.start >= replayStart {
+					continue
+				}
+				restText := name[rest.start:rest.end]
+				if !patterns[rest.pattern].handler(restText, &scratch) {
+					ignored = append(ignored, ignoredToken(patterns[rest.pattern].pattern, restText))
+				}
+				replayStart = rest.start
+			}
+			info.addIgnored(ignored)
 			break
 		}
 	}
@@ -502,8 +1389,10 @@ func scanPossibleMetadataPhase1(name string, info *TorrentInfo, startPos int) in
 }
 
 // scanPossibleMetadataPhase2 scans for possible metadata from current metadata start towards beginning
-func scanPossibleMetadataPhase2(name string, info *TorrentInfo, startPos int) int {
+func (p *Parser) scanPossibleMetadataPhase2(name string, info *TorrentInfo, startPos int) int {
 	metadataStartPos := startPos
+	animeStyle := animeStylePattern.MatchString(name)
+	specialsTitled := titledSpecials(name)
 
 	// Extending metadata patterns (can be found in step 3)
 	// These are metadata that can extend the title boundary backwards
@@ -511,55 +1400,173 @@ func scanPossibleMetadataPhase2(name string, info *TorrentInfo, startPos int) in
 		pattern *regexp.Regexp
 		handler func(string, *TorrentInfo) bool
 	}{
+		{yearDCEditionPattern, func(match string, info *TorrentInfo) bool {
+			if info.Year == 0 && info.Edition == "" {
+				submatch := yearDCEditionPattern.FindStringSubmatch(match)
+				if year, err := strconv.Atoi(submatch[1]); err == nil && p.isReleaseYear(year) {
+					info.Year = year
+					info.Edition = editionName(submatch[2])
+					return true
+				}
+			}
+			return false
+		}},
+		{yearThreeDPattern, func(match string, info *TorrentInfo) bool {
+			if info.Year == 0 && !info.Is3D {
+				submatch := yearThreeDPattern.FindStringSubmatch(match)
+				if year, err := strconv.Atoi(submatch[1]); err == nil && p.isReleaseYear(year) {
+					info.Year = year
+					info.Is3D = true
+					return true
+				}
+			}
+			return false
+		}},
 		{yearPattern, func(match string, info *TorrentInfo) bool {
 			if info.Year == 0 {
-				if year, err := strconv.Atoi(match); err == nil && year >= 1895 && year <= time.Now().Year() {
+				if year, err := strconv.Atoi(match); err == nil && p.isReleaseYear(year) {
 					info.Year = year
 					return true
 				}
 			}
 			return false
 		}},
-		{editionPattern, func(match string, info *TorrentInfo) bool {
-			if info.Edition == "" {
-				// Normalize multi-word editions by replacing dots with spaces
-				norm := strings.ReplaceAll(match, ".", " ")
-				info.Edition = strings.Title(strings.ToLower(norm))
+		{editionPattern, func(match string, info *TorrentInfo) bool {
+			if info.Edition == "" {
+				info.Edition = editionName(match)
+				return true
+			}
+			return false
+		}},
+		{specialsPattern, func(match string, info *TorrentInfo) bool {
+			// After nothing but an article it is the title (The.Specials.2019)
+			if !info.HasSeason && info.Episode == 0 && specialsTitled {
+				info.Season = 0
+				info.HasSeason = true
+				return true
+			}
+			return false
+		}},
+		{completePattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsComplete {
+				info.IsComplete = true
+				if strings.Contains(strings.ToLower(match), "series") {
+					info.IsCompleteSeries = true
+				}
+				return true
+			}
+			return false
+		}},
+		{hdrPattern, func(match string, info *TorrentInfo) bool {
+			return info.addHDR(match)
+		}},
+		{qualitativeResolutionPattern, func(match string, info *TorrentInfo) bool {
+			// Only in front of metadata without a line count (Movie.2020.FHD.BluRay);
+			// before an episode code it ends the title (Planet.Earth.UHD.S01E01)
+			if info.Resolution == "" && !info.HasSeason && info.Episode == 0 && info.Date == "" {
+				info.Resolution = qualitativeResolutions[strings.ToUpper(match)]
+				return true
+			}
+			return false
+		}},
+		{miniseriesPattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsMiniseries {
+				info.IsMiniseries = true
+				return true
+			}
+			return false
+		}},
+		{extraPattern, func(match string, info *TorrentInfo) bool {
+			// Before a year or episode code, these words belong to the title
+			if !info.IsExtra && info.Year == 0 && !info.HasSeason && info.Episode == 0 {
+				info.IsExtra = true
+				info.ExtraType = extraName(match)
+				return true
+			}
+			return false
+		}},
+		{properPattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsProper {
+				info.IsProper = true
+				return true
+			}
+			return false
+		}},
+		{repackPattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsRepack {
+				info.IsRepack = true
+				return true
+			}
+			return false
+		}},
+		{hardcodedPattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsHardcoded {
+				info.IsHardcoded = true
+				return true
+			}
+			return false
+		}},
+		{dubbedPattern, func(match string, info *TorrentInfo) bool {
+			if !info.IsDubbed {
+				info.IsDubbed = true
 				return true
 			}
 			return false
 		}},
-		{completePattern, func(match string, info *TorrentInfo) bool {
-			if !info.IsComplete {
-				info.IsComplete = true
+		{sceneTagPattern, func(match string, info *TorrentInfo) bool {
+			return info.addSceneTag(match)
+		}},
+		{audioBitratePattern, func(match string, info *TorrentInfo) bool {
+			if info.AudioBitrate == "" {
+				info.AudioBitrate = audioBitratePattern.FindStringSubmatch(match)[1] + "Kbps"
 				return true
 			}
 			return false
 		}},
-		{properPattern, func(match string, info *TorrentInfo) bool {
-			if !info.IsProper {
-				info.IsProper = true
+		{audioBitDepthPattern, func(match string, info *TorrentInfo) bool {
+			if info.AudioBitDepth == "" {
+				info.AudioBitDepth = audioBitDepthPattern.FindStringSubmatch(match)[1] + "bit"
 				return true
 			}
 			return false
 		}},
-		{repackPattern, func(match string, info *TorrentInfo) bool {
-			if !info.IsRepack {
-				info.IsRepack = true
+		{audioSampleRatePattern, func(match string, info *TorrentInfo) bool {
+			if info.AudioSampleRate == "" {
+				info.AudioSampleRate = audioSampleRatePattern.FindStringSubmatch(match)[1] + "kHz"
 				return true
 			}
 			return false
 		}},
-		{hardcodedPattern, func(match string, info *TorrentInfo) bool {
-			if !info.IsHardcoded {
-				info.IsHardcoded = true
+		{volumePattern, func(match string, info *TorrentInfo) bool {
+			if info.Volume == 0 && info.Year == 0 && animeStyle {
+				info.Volume, _ = strconv.Atoi(volumePattern.FindStringSubmatch(match)[1])
+				return true
+			}
+			return false
+		}},
+		{partPattern, func(match string, info *TorrentInfo) bool {
+			if info.Part == 0 {
+				info.Part = partNumber(match)
+				return true
+			}
+			return false
+		}},
+		{threeDLayoutPattern, func(match string, info *TorrentInfo) bool {
+			if info.ThreeDLayout == "" {
+				info.ThreeDLayout = threeDLayoutName(match)
 				return true
 			}
 			return false
 		}},
 		{languagePattern, func(match string, info *TorrentInfo) bool {
-			if info.Language == "" {
-				info.Language = strings.Title(strings.ToLower(match))
+			info.addLanguage(match)
+			return true
+		}},
+		{languageCodePattern, func(match string, info *TorrentInfo) bool {
+			// A code in front of the year or TV numbering is more likely the end of
+			// the title (TOP.CAT.2011, TOP.CAT.S01E01)
+			if info.Year == 0 && !info.HasSeason && info.Episode == 0 && info.Date == "" {
+				info.addLanguage(match)
 				return true
 			}
 			return false
@@ -567,7 +1574,7 @@ func scanPossibleMetadataPhase2(name string, info *TorrentInfo, startPos int) in
 		{subsPattern, func(match string, info *TorrentInfo) bool {
 			if len(info.Subtitles) == 0 {
 				// Try to find specific subtitle languages
-				subLanguages := regexp.MustCompile(`(?i)(ENG|FRE|SPA|GER|ITA|DAN|DUT|JAP|CHI|RUS|POL|VIE|SWE|NOR|FIN|TUR|POR|KOR)[\.\s]?SUBS`).FindAllStringSubmatch(match, -1)
+				subLanguages := subLanguagePattern.FindAllStringSubmatch(match, -1)
 				for _, submatch := range subLanguages {
 					info.Subtitles = append(info.Subtitles, submatch[1])
 				}
@@ -584,7 +1591,7 @@ func scanPossibleMetadataPhase2(name string, info *TorrentInfo, startPos int) in
 			if info.ReleaseGroup == "" {
 				if submatch := releaseGroupPattern.FindStringSubmatch(match); submatch != nil {
 					group := submatch[1]
-					if !isQualityTag(group) && len(group) >= 2 {
+					if p.isReleaseGroup(group) {
 						info.ReleaseGroup = group
 						return true
 					}
@@ -594,26 +1601,49 @@ func scanPossibleMetadataPhase2(name string, info *TorrentInfo, startPos int) in
 		}},
 	}
 
-	// Find all matches and sort by position (descending for back-to-front scan)
-	var matches []struct {
-		start, end int
-		pattern    int
+	// Bare numeric episode codes are ambiguous with numbers in titles, so they
+	// are opt-in and only read when nothing else dates or numbers the release
+	if p.numericEpisodeCodes {
+		patterns = append(patterns, struct {
+			pattern *regexp.Regexp
+			handler func(string, *TorrentInfo) bool
+		}{numericEpisodePattern, func(match string, info *TorrentInfo) bool {
+			if info.HasSeason || info.Episode != 0 || info.Year != 0 || info.Date != "" {
+				return false
+			}
+			if n, _ := strconv.Atoi(match); p.isReleaseYear(n) {
+				return false
+			}
+			submatch := numericEpisodePattern.FindStringSubmatch(match)
+			season, _ := strconv.Atoi(submatch[1])
+			episode, _ := strconv.Atoi(submatch[2])
+			if season == 0 || episode == 0 {
+				return false
+			}
+			info.Season, info.Episode = season, episode
+			info.HasSeason = true
+			return true
+		}})
 	}
 
+	// Find all matches and sort by position (descending for back-to-front scan)
+	buf := getMatches()
+	defer putMatches(buf)
+	matches := (*buf)[:0]
+
 	for i, p := range patterns {
 		allMatches := p.pattern.FindAllStringIndex(name, -1)
 		for _, match := range allMatches {
-			matches = append(matches, struct {
-				start, end int
-				pattern    int
-			}{match[0], match[1], i})
+			matches = append(matches, scanMatch{match[0], match[1], i})
 		}
 	}
+	*buf = matches
 
-	// Sort by start position (descending for back-to-front scan)
+	// Sort by start position (descending for back-to-front scan); of two matches
+	// starting together the longer comes first (1982.DC before 1982)
 	for i := 0; i < len(matches); i++ {
 		for j := i + 1; j < len(matches); j++ {
-			if matches[i].start < matches[j].start {
+			if matches[i].start < matches[j].start || (matches[i].start == matches[j].start && matches[i].end < matches[j].end) {
 				matches[i], matches[j] = matches[j], matches[i]
 			}
 		}
@@ -625,7 +1655,9 @@ func scanPossibleMetadataPhase2(name string, info *TorrentInfo, startPos int) in
 			continue // Skip if already past our metadata start
 		}
 
-		// Don't consume the first word of the entire name as metadata
+		// Don't consume the first word of the entire name as metadata. This is what
+		// keeps year-like titles intact: the last plausible year is the release year
+		// and an earlier number ("2012.2009", "1917.2019") belongs to the title.
 		if match.start == 0 {
 			break
 		}
@@ -648,6 +1680,39 @@ func scanPossibleMetadataPhase2(name string, info *TorrentInfo, startPos int) in
 	return metadataStartPos
 }
 
+// leadingMetadataEnd returns the end of a run of quality tokens (resolution,
+// source, codec) at the very start of name, or 0 if there is none. To avoid eating
+// titles such as "Cam.Girl", the run must hold a resolution or at least two tokens,
+// and something other than separators must follow it.
+func leadingMetadataEnd(name string) int {
+	pos, end, tokens, hasResolution := 0, 0, 0, false
+	for {
+		for pos < len(name) && isOnlySeparators(name[pos:pos+1]) {
+			pos++
+		}
+
+		matched := false
+		for _, pattern := range []*regexp.Regexp{resolutionPattern, sourcePattern, codecPattern} {
+			if loc := pattern.FindStringIndex(name[pos:]); loc != nil && loc[0] == 0 {
+				hasResolution = hasResolution || pattern == resolutionPattern
+				pos += loc[1]
+				end = pos
+				tokens++
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			break
+		}
+	}
+
+	if tokens == 0 || (!hasResolution && tokens < 2) || isOnlySeparators(name[end:]) {
+		return 0
+	}
+	return end
+}
+
 // isAdjacentToMetadataStart checks if a metadata position is adjacent to the current metadata start
 func isAdjacentToMetadataStart(start, end, metadataStartPos int, name string) bool {
 	// If this metadata ends at the metadata start position, it's adjacent
@@ -674,6 +1739,12 @@ func isAdjacentToMetadataStart(start, end, metadataStartPos int, name string) bo
 	return false
 }
 
+// startsWithLetter reports whether s begins with a letter, in any script
+func startsWithLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
 // isOnlySeparators returns true if the string contains only separator characters
 func isOnlySeparators(s string) bool {
 	for _, c := range s {
@@ -684,26 +1755,33 @@ func isOnlySeparators(s string) bool {
 	return true
 }
 
-// extractUnparsedContent extracts everything after metadata start that isn't metadata
-func extractUnparsedContent(name string, metadataStartPos int) string {
+// extractUnparsedContent extracts everything after metadata start that isn't metadata.
+// Matches of extra (patterns enabled by Parser options) count as metadata too.
+func extractUnparsedContent(name string, metadataStartPos int, extra ...*regexp.Regexp) string {
 	if metadataStartPos >= len(name) {
 		return ""
 	}
 
-	afterMetadata := name[metadataStartPos:]
+	// Underscores are separators too; replace them before matching so \b works
+	afterMetadata := strings.ReplaceAll(name[metadataStartPos:], "_", " ")
 
 	// Find all metadata patterns in the remaining text
 	metadataPatterns := []*regexp.Regexp{
-		resolutionPattern, sourcePattern, codecPattern, audioPattern,
-		languagePattern, completePattern, properPattern, repackPattern, hardcodedPattern,
-		editionPattern, yearPattern, releaseGroupPattern,
-		seasonPattern, seasonAltPattern, episodePattern, altEpisodePattern,
+		resolutionPattern, dimensionsPattern, qualitativeResolutionPattern, sourcePattern, discTypePattern, codecPattern, hdrPattern, audioPattern,
+		languagePattern, languageCodePattern, completePattern, miniseriesPattern, extraPattern, properPattern, repackPattern, hardcodedPattern, dubbedPattern,
+		editionPattern, dcEditionPattern, yearPattern, releaseGroupPattern,
+		subsPattern, threeDPattern, threeDLayoutPattern, sceneTagPattern, volumePattern, partPattern,
+		audioBitratePattern, audioBitDepthPattern, audioSampleRatePattern,
+		seasonPattern, seasonAltPattern, specialsPattern, episodePattern, altEpisodePattern, episodeCountPattern, episodeTypePattern, numberedEpisodeTypePattern,
 		monoStereoPattern, channelPattern,
+		// WEB source companion tokens
+		webDLHintPattern, webRipHintPattern,
 		// Audio channel enhancements
-		regexp.MustCompile(`(?i)\b(ATMOS|DTS-X|DTS-HD|DTS-HD MA|DTS-ES|DD\+|DD|EAC3)\b`),
+		audioExtraPattern, audioGluedPattern,
 		// Date component patterns
-		regexp.MustCompile(`(?i)\b\d{1,2}\.\d{1,2}\b`), // 10.15, 12.25, etc.
+		datePartPattern, // 10.15, 12.25, etc.
 	}
+	metadataPatterns = append(metadataPatterns, extra...)
 
 	// Remove all metadata from the unparsed content
 	result := afterMetadata
@@ -712,59 +1790,243 @@ func extractUnparsedContent(name string, metadataStartPos int) string {
 	}
 
 	// Remove leftover episode-only codes like E01, E02, etc.
-	result = regexp.MustCompile(`(?i)\bE\d{1,3}\b`).ReplaceAllString(result, "")
+	result = bareEpisodePattern.ReplaceAllString(result, "")
 
-	// Clean up extra spaces and separators
+	// Clean up brackets emptied by the removals, extra spaces and separators
+	result = emptyBracketsPattern.ReplaceAllString(result, "")
 	result = strings.ReplaceAll(result, ".", " ")
 	result = strings.ReplaceAll(result, "-", " ")
-	result = regexp.MustCompile(`\s+`).ReplaceAllString(result, " ")
+	result = strings.ReplaceAll(result, "+", " ")
 
-	return strings.TrimSpace(result)
+	return NormalizeWhitespace(result)
 }
 
-// isReasonableYear checks if a string is a reasonable year
-func isReasonableYear(s string) bool {
-	if year, err := strconv.Atoi(s); err == nil {
-		return year >= 1895 && year <= time.Now().Year()
+// addSceneTag records a scene tag, reporting false for a repeated tag or a REAL
+// that doesn't qualify a PROPER or REPACK. The scans run back-to-front, so a
+// PROPER after REAL has already been seen and each new tag goes first.
+func (info *TorrentInfo) addSceneTag(token string) bool {
+	tag := strings.ToUpper(token)
+	if tag == "REAL" && !info.IsProper && !info.IsRepack {
+		return false
 	}
-	return false
+	for _, existing := range info.SceneTags {
+		if existing == tag {
+			return false
+		}
+	}
+	info.SceneTags = append([]string{tag}, info.SceneTags...)
+	return true
 }
 
-// ParseWithHints parses with tracker-specific hints
-func ParseWithHints(name string, tracker string) *TorrentInfo {
-	// Input validation
-	if name == "" {
-		return Parse(name) // Will return empty result with 0 confidence
+// setEpisodeType records an anime episode sub-type and its number from a match
+// of pattern, reporting false if a type is already set
+func (info *TorrentInfo) setEpisodeType(pattern *regexp.Regexp, match string) bool {
+	if info.EpisodeType != "" {
+		return false
+	}
+	submatch := pattern.FindStringSubmatch(match)
+	info.EpisodeType = episodeTypeName(submatch[1])
+	if submatch[2] != "" {
+		info.AbsoluteEpisode, _ = strconv.Atoi(submatch[2])
+	}
+	return true
+}
+
+// addHDR records an HDR format token found by a back-to-front scan, keeping
+// HDR in name order. It reports false for a format already recorded (HDR next
+// to HDR10), which ends the scan like any repeated metadata.
+func (info *TorrentInfo) addHDR(token string) bool {
+	format := hdrName(token)
+	var formats []string
+	if info.HDR != "" {
+		formats = strings.Split(info.HDR, " / ")
+	}
+	for _, existing := range formats {
+		if existing == format {
+			return false
+		}
+	}
+	info.HDR = strings.Join(append([]string{format}, formats...), " / ")
+	return true
+}
+
+// hdrName normalizes an HDR format token: bare HDR is HDR10, DV and DoVi are
+// Dolby Vision
+func hdrName(token string) string {
+	switch upper := strings.ToUpper(token); {
+	case upper == "DV" || upper == "DOVI" || strings.HasPrefix(upper, "DOLBY"):
+		return "Dolby Vision"
+	case upper == "HDR10+" || upper == "HDR10PLUS":
+		return "HDR10+"
+	case upper == "HLG":
+		return "HLG"
+	default:
+		return "HDR10"
+	}
+}
+
+// ignoredToken returns the token of a match of pattern to report in Ignored:
+// the match itself, or for a release group the group without its hyphen
+func ignoredToken(pattern *regexp.Regexp, match string) string {
+	if pattern == releaseGroupPattern {
+		return releaseGroupPattern.FindStringSubmatch(match)[1]
+	}
+	return match
+}
+
+// addIgnored records duplicate tokens collected by a back-to-front scan,
+// keeping Ignored in name order
+func (info *TorrentInfo) addIgnored(tokens []string) {
+	for i := len(tokens) - 1; i >= 0; i-- {
+		info.Ignored = append(info.Ignored, tokens[i])
+	}
+}
+
+// addLanguage records a language token. Languages keeps every distinct language in
+// name order and Language the earliest one; the scans run back-to-front, so each
+// new token is the earliest seen so far. A language already recorded, whether
+// repeated as is or as a specific tag after a general one (FRENCH.VFF), is only
+// recorded once; unlike other repeated metadata it does not end the scan, as
+// names that list several languages often list one twice.
+func (info *TorrentInfo) addLanguage(token string) {
+	language := languageName(token)
+	info.Language = language
+	for _, existing := range info.Languages {
+		if existing == language {
+			return
+		}
 	}
+	info.Languages = append([]string{language}, info.Languages...)
+}
 
-	info := Parse(name)
+// moveMultiToSubtitles reports "Multi" as a subtitle language instead of a
+// spoken one, replacing the "Unknown" placeholder
+func (info *TorrentInfo) moveMultiToSubtitles() {
+	var languages []string
+	for _, language := range info.Languages {
+		if language != "Multi" {
+			languages = append(languages, language)
+		}
+	}
+	info.Languages = languages
+	info.Language = ""
+	if len(languages) > 0 {
+		info.Language = languages[0]
+	}
 
-	// Apply tracker-specific adjustments
-	switch strings.ToLower(tracker) {
-	case "btn", "broadcasthenet":
-		// BTN uses "Season X Complete" format
-		if match := btnSeasonPack.FindStringSubmatch(name); match != nil {
-			info.Season, _ = strconv.Atoi(match[1])
-			info.IsComplete = true
+	var subtitles []string
+	for _, subtitle := range info.Subtitles {
+		if subtitle != "Unknown" {
+			subtitles = append(subtitles, subtitle)
 		}
+	}
+	info.Subtitles = appendUnique(subtitles, "Multi")
+}
+
+// audioToken normalizes an audio token: canonical casing (TrueHD, Atmos, DTS),
+// hyphenated codecs joined (E-AC-3 -> EAC3, AC-3 -> AC3) and glued channels
+// split (DDP5.1 -> DDP 5.1)
+func audioToken(match string) string {
+	token := strings.ToUpper(match)
+	if submatch := audioGluedPattern.FindStringSubmatch(token); submatch != nil {
+		return audioName(submatch[1]) + " " + submatch[2]
+	}
+	return audioName(token)
+}
+
+// audioNames gives the display casing of audio tokens whose canonical form
+// isn't all capitals, keyed by the upper-cased token
+var audioNames = map[string]string{
+	"TRUEHD": "TrueHD",
+	"ATMOS":  "Atmos",
+	"OPUS":   "Opus",
+	"MONO":   "Mono",
+	"STEREO": "Stereo",
+	"E-AC-3": "EAC3",
+	"AC-3":   "AC3",
+}
+
+// audioName canonicalizes an upper-cased audio token. Acronyms such as DTS,
+// FLAC, AAC, DD+ and EAC3 stay in capitals.
+func audioName(token string) string {
+	if name, ok := audioNames[token]; ok {
+		return name
+	}
+	return token
+}
+
+// extractAudioLanguages collects language codes that appear in a run of audio tokens
+// (e.g. "TrueHD.ENG-FRE" or "DTS.ENG+FRE") after the metadata start. It also
+// returns the spans of the language codes that follow an audio token in their
+// run, which name audio tracks rather than the release language.
+func extractAudioLanguages(name string, metadataStartPos int) ([]string, []textSpan) {
+	if metadataStartPos >= len(name) {
+		return nil, nil
+	}
+
+	type span struct {
+		start, end int
+		language   string // empty for audio tokens
+	}
 
-	case "ptp", "passthepopcorn":
-		// PTP sometimes uses year ranges for collections
-		if match := ptnYearRange.FindStringSubmatch(name); match != nil {
-			info.Year, _ = strconv.Atoi(match[1])
-			// Could store end year in a new field if needed
+	var spans []span
+	for _, pattern := range []*regexp.Regexp{audioPattern, audioExtraPattern, audioGluedPattern, channelPattern, monoStereoPattern} {
+		for _, match := range pattern.FindAllStringIndex(name[metadataStartPos:], -1) {
+			spans = append(spans, span{metadataStartPos + match[0], metadataStartPos + match[1], ""})
 		}
+	}
+	for _, match := range audioLanguagePattern.FindAllStringIndex(name[metadataStartPos:], -1) {
+		start, end := metadataStartPos+match[0], metadataStartPos+match[1]
+		spans = append(spans, span{start, end, languageName(name[start:end])})
+	}
+	if len(spans) == 0 {
+		return nil, nil
+	}
 
-	case "hdb", "hdbits":
-		// HDBits has very standardized naming
-		if info.Confidence*11 < 100 {
-			info.Confidence = info.Confidence * 11 / 10
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	// Walk runs of adjacent tokens; keep the languages of runs that contain audio
+	var languages, run []string
+	var trackCodes []textSpan
+	hasAudio := false
+	flush := func() {
+		if hasAudio {
+			for _, language := range run {
+				languages = appendUnique(languages, language)
+			}
+		}
+		run, hasAudio = nil, false
+	}
+	runEnd := spans[0].start
+	for _, sp := range spans {
+		if sp.start > runEnd && !isOnlySeparators(strings.ReplaceAll(name[runEnd:sp.start], "+", "")) {
+			flush()
+		}
+		if sp.end > runEnd {
+			runEnd = sp.end
+		}
+		if sp.language == "" {
+			hasAudio = true
 		} else {
-			info.Confidence = 100
+			run = append(run, sp.language)
+			if hasAudio && !languagePattern.MatchString(name[sp.start:sp.end]) {
+				trackCodes = append(trackCodes, textSpan{sp.start, sp.end})
+			}
 		}
 	}
+	flush()
 
-	return info
+	return languages, trackCodes
+}
+
+// appendUnique appends s to list unless it is already present
+func appendUnique(list []string, s string) []string {
+	for _, existing := range list {
+		if existing == s {
+			return list
+		}
+	}
+	return append(list, s)
 }
 
 func extractTitle(name string, info *TorrentInfo) string {
@@ -811,6 +2073,11 @@ func extractTitleFromPosition(name string, metadataStartPos int) string {
 	if metadataStartPos > len(name) {
 		panic("metadata start position exceeds string length in extractTitleFromPosition - parsing logic error")
 	}
+	// Positions come from regexp matches and separator bytes, which are always
+	// rune boundaries; anything else would cut a multibyte title character
+	if metadataStartPos < len(name) && !utf8.RuneStart(name[metadataStartPos]) {
+		panic("metadata start position splits a rune in extractTitleFromPosition - parsing logic error")
+	}
 
 	title := name[:metadataStartPos]
 	// Trim trailing separators (dot, space, dash, underscore)
@@ -818,6 +2085,13 @@ func extractTitleFromPosition(name string, metadataStartPos int) string {
 	return strings.TrimSpace(cleanString(title))
 }
 
+// CleanTitle cleans s the way parsed titles are cleaned: dots and underscores
+// become spaces, bracketed groups ([...] anywhere, a trailing (...)) are
+// removed, and white space is collapsed and trimmed
+func CleanTitle(s string) string {
+	return cleanString(s)
+}
+
 func cleanString(s string) string {
 	// Input validation
 	if s == "" {
@@ -829,81 +2103,402 @@ func cleanString(s string) string {
 	s = strings.ReplaceAll(s, "_", " ")
 
 	// Remove brackets and their contents (often contains metadata)
-	s = regexp.MustCompile(`\[[^\]]+\]`).ReplaceAllString(s, "")
-	s = regexp.MustCompile(`\([^\)]+\)$`).ReplaceAllString(s, "")
+	s = removeBracketed(s)
+	s = removeTrailingParen(s)
 
 	// Clean up extra spaces
-	s = regexp.MustCompile(`\s+`).ReplaceAllString(s, " ")
+	return NormalizeWhitespace(s)
+}
+
+// removeBracketed removes every non-empty [...] group. An opening bracket
+// without a matching close is kept, as is an empty [] pair.
+func removeBracketed(s string) string {
+	if strings.IndexByte(s, '[') < 0 {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for {
+		open := strings.IndexByte(s, '[')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(s[open+1:], ']')
+		if end < 0 {
+			break
+		}
+		if end == 0 {
+			// Empty pair is kept; resume after the opening bracket
+			b.WriteString(s[:open+1])
+			s = s[open+1:]
+			continue
+		}
+		b.WriteString(s[:open])
+		s = s[open+1+end+1:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
 
-	return strings.TrimSpace(s)
+// removeTrailingParen removes a non-empty (...) group that ends the string,
+// starting from the first ( after any earlier )
+func removeTrailingParen(s string) string {
+	last := len(s) - 1
+	if last < 0 || s[last] != ')' {
+		return s
+	}
+	from := strings.LastIndexByte(s[:last], ')') + 1
+	open := strings.IndexByte(s[from:last], '(')
+	if open < 0 {
+		return s
+	}
+	open += from
+	if open == last-1 {
+		return s
+	}
+	return s[:open]
+}
+
+// countryCodes are the country codes series names append to their title to
+// tell remakes apart (The.Office.US, Shameless.UK)
+var countryCodes = map[string]bool{
+	"US": true, "UK": true, "AU": true, "NZ": true, "CA": true,
+}
+
+// splitCountry splits a trailing upper-case country code off a series title.
+// The code must follow at least one other word and the title must not be all
+// capitals, where "US" could just as well be the word "Us".
+func splitCountry(title string) (string, string) {
+	i := strings.LastIndexByte(title, ' ')
+	if i <= 0 {
+		return title, ""
+	}
+	rest, code := title[:i], title[i+1:]
+	if !countryCodes[code] || strings.ToUpper(rest) == rest {
+		return title, ""
+	}
+	return rest, code
+}
+
+// NormalizeWhitespace collapses every run of Unicode white space, including
+// no-break spaces (U+00A0), into a single ASCII space and trims both ends
+func NormalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// episodeTypeName normalizes an anime episode sub-type token
+func episodeTypeName(token string) string {
+	switch strings.ToUpper(token) {
+	case "OVA", "OAD":
+		return "OVA"
+	case "ONA":
+		return "ONA"
+	case "SPECIAL", "SP":
+		return "Special"
+	default:
+		return "Movie"
+	}
+}
+
+// threeDLayoutName normalizes a 3D layout token: half side-by-side (HSBS),
+// full side-by-side (SBS), half over-under (HOU), full over-under (OU) or MVC.
+// Top-and-bottom (TAB) is another name for over-under.
+func threeDLayoutName(token string) string {
+	upper := strings.NewReplacer("-", "", ".", "", " ", "").Replace(strings.ToUpper(token))
+	switch upper {
+	case "HSBS", "HALFSBS":
+		return "HSBS"
+	case "SBS", "FSBS":
+		return "SBS"
+	case "HOU", "HALFOU", "HTAB":
+		return "HOU"
+	case "OU", "FOU", "TAB":
+		return "OU"
+	}
+	return upper
+}
+
+// partOrdinals maps the spelled-out part numbers partPattern accepts
+var partOrdinals = map[string]int{
+	"one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+}
+
+// partNumber reads the number of a part token: CD2, Part.2 and Part.Two all give 2
+func partNumber(token string) int {
+	m := partPattern.FindStringSubmatch(token)
+	if m[1] != "" {
+		n, _ := strconv.Atoi(m[1])
+		return n
+	}
+	if n, ok := partOrdinals[strings.ToLower(m[2])]; ok {
+		return n
+	}
+	n, _ := strconv.Atoi(m[2])
+	return n
+}
+
+// editionName normalizes an edition token. Every spelling of the director's
+// cut (Directors.Cut, Director's Cut, DC) becomes "Directors Cut"; other
+// multi-word editions have their dots replaced with spaces.
+func editionName(token string) string {
+	upper := strings.ToUpper(token)
+	if upper == "DC" || strings.HasPrefix(upper, "DIRECTOR") {
+		return "Directors Cut"
+	}
+	return titleCase(strings.ReplaceAll(token, ".", " "))
+}
+
+// resolutionName normalizes a resolution token: lower case, with 4K as 2160p
+func resolutionName(token string) string {
+	if strings.EqualFold(token, "4K") {
+		return "2160p"
+	}
+	return strings.ToLower(token)
+}
+
+// codecName normalizes a codec token, also returning it as an encoder name that
+// keeps the encoder-vs-standard distinction (x264 vs H264)
+func codecName(token string) (codec, encoder string) {
+	// The dotted form (H.264) is the same codec as H264
+	upper := strings.ToUpper(separatorRemover.Replace(token))
+	switch upper {
+	case "H264", "X264", "AVC":
+		codec = "H264"
+	case "H265", "X265", "HEVC":
+		codec = "H265"
+	default:
+		codec = upper
+	}
+	if upper == "X264" || upper == "X265" {
+		return codec, strings.ToLower(upper)
+	}
+	return codec, upper
+}
+
+// sourceName normalizes a source token. A bare WEB stays WEB; see webSourceName.
+func sourceName(token string) string {
+	upper := strings.ToUpper(token)
+	// A WEB-DLRip is a rip of a WEB-DL, however it is spelled
+	if strings.HasPrefix(upper, "WEB") && strings.HasSuffix(upper, "RIP") {
+		upper = "WEBRIP"
+	}
+	switch upper {
+	case "BLURAY", "BLU-RAY":
+		return "BluRay"
+	case "REMUX", "BDREMUX":
+		return "REMUX"
+	case "WEB-DL", "WEBDL", "WEB.DL", "WEB DL":
+		return "WEB-DL"
+	case "WEBRIP":
+		return "WEBRip"
+	case "BDMUX":
+		// A Blu-ray video muxed with other audio tracks
+		return "BluRay"
+	case "HDTVRIP":
+		return "HDTV"
+	case "DSRIP", "SATRIP":
+		return "DSR"
+	default:
+		return upper
+	}
+}
+
+// webSourceName resolves a bare WEB source with the help of the metadata after
+// the title: a DL token or premium service tag implies WEB-DL, a Rip token
+// implies WEBRip
+func webSourceName(metadata string) string {
+	switch {
+	case webDLHintPattern.MatchString(metadata):
+		return "WEB-DL"
+	case webRipHintPattern.MatchString(metadata):
+		return "WEBRip"
+	default:
+		return "WEB"
+	}
+}
+
+// extraNames maps upper-cased, space-separated extras tokens to their display form
+var extraNames = map[string]string{
+	"DELETED SCENES":    "Deleted Scenes",
+	"DELETEDSCENES":     "Deleted Scenes",
+	"BEHIND THE SCENES": "Behind the Scenes",
+	"BEHINDTHESCENES":   "Behind the Scenes",
+	"MAKING OF":         "Making Of",
+	"FEATURETTE":        "Featurette",
+	"FEATURETTES":       "Featurette",
+	"TRAILER":           "Trailer",
+	"TRAILERS":          "Trailer",
+	"BONUS":             "Bonus",
+	"EXTRAS":            "Extras",
+}
+
+// extraName returns the display form of an extras token
+func extraName(token string) string {
+	return extraNames[strings.ToUpper(separatorReplacer.Replace(token))]
+}
+
+// titleCase title-cases s with a language-neutral caser, lowercasing the rest of
+// each word. Casers are stateful, so one is made per call to keep parsing safe
+// for concurrent use.
+func titleCase(s string) string {
+	return cases.Title(language.Und).String(s)
 }
 
 func isQualityTag(s string) bool {
-	qualityTags := []string{
-		"1080p", "720p", "480p", "2160p", "4K",
-		"BluRay", "WEBRip", "HDTV", "WEB",
-		"x264", "x265", "H264", "H265",
-		"AAC", "AC3", "DTS", "FLAC",
-		"PROPER", "REPACK",
+	return qualityTags[strings.ToUpper(s)]
+}
+
+// collabAnchors are the quality tokens a hyphenated release group follows
+var collabAnchors = []*regexp.Regexp{
+	resolutionPattern, sourcePattern, codecPattern, hdrPattern, audioPattern, audioExtraPattern, channelPattern,
+}
+
+// qualityTags holds the upper-cased tokens isQualityTag rejects as release groups
+var qualityTags = map[string]bool{
+	"1080P": true, "720P": true, "480P": true, "1440P": true, "2160P": true, "4320P": true, "4K": true,
+	"BLURAY": true, "WEBRIP": true, "HDTV": true, "WEB": true,
+	"X264": true, "X265": true, "H264": true, "H265": true,
+	"AAC": true, "AC3": true, "DTS": true, "FLAC": true,
+	"PROPER": true, "REPACK": true,
+}
+
+// qualitativeResolutions maps resolution-less quality tags to line counts. A
+// bare HD is taken as 720p, the lowest resolution sold as HD, since FHD is
+// what names use for 1080p.
+var qualitativeResolutions = map[string]string{
+	"UHD": "2160p",
+	"FHD": "1080p",
+	"HD":  "720p",
+}
+
+// dimensionClasses maps frame sizes to resolution tags, largest first. A frame
+// belongs to the first class it reaches in either width or height, so cropped
+// (1920x800) and anamorphic (1440x1080) frames land where expected.
+var dimensionClasses = []struct {
+	width, height int
+	resolution    string
+}{
+	{7680, 4320, "4320p"},
+	{3840, 2160, "2160p"},
+	{2560, 1440, "1440p"},
+	{1920, 1080, "1080p"},
+	{1280, 720, "720p"},
+	{640, 480, "480p"},
+}
+
+// dimensionsResolution maps a WIDTHxHEIGHT frame size to its resolution tag
+// using dimensionClasses
+func dimensionsResolution(match string) string {
+	submatch := dimensionsPattern.FindStringSubmatch(match)
+	if submatch == nil {
+		return ""
 	}
+	width, _ := strconv.Atoi(submatch[1])
+	height, _ := strconv.Atoi(submatch[2])
 
-	upper := strings.ToUpper(s)
-	for _, tag := range qualityTags {
-		if strings.ToUpper(tag) == upper {
-			return true
+	for _, class := range dimensionClasses {
+		if width >= class.width || height >= class.height {
+			return class.resolution
 		}
 	}
-	return false
+	return ""
+}
+
+// setKind derives Kind from the season, episode and air date numbering
+func (info *TorrentInfo) setKind() {
+	switch {
+	case info.Episode != 0 || info.AbsoluteEpisode != 0 || info.Date != "":
+		info.Kind = KindEpisode
+	case info.HasSeason || info.IsCompleteSeries:
+		info.Kind = KindSeason
+	default:
+		info.Kind = KindMovie
+	}
+}
+
+// setResolutionFlags derives Is4K and IsHD from the normalized Resolution value
+func (info *TorrentInfo) setResolutionFlags() {
+	switch info.Resolution {
+	case "2160p", "4320p":
+		info.Is4K = true
+	case "720p", "1080p", "1440p":
+		info.IsHD = true
+	}
 }
 
-func (info *TorrentInfo) calculateConfidence() {
+func (info *TorrentInfo) calculateConfidence(w Weights) {
 	conf := 0
 	// Year or Season (or both)
-	if info.Year != 0 || info.Season != 0 {
-		conf += YearSeasonWeight
+	if info.Year != 0 || info.HasSeason {
+		conf += w.YearSeason
 	}
 	// Resolution
 	if info.Resolution != "" {
-		conf += ResolutionWeight
+		conf += w.Resolution
 	}
 	// Source
 	if info.Source != "" {
-		conf += SourceWeight
+		conf += w.Source
 	}
 	// ReleaseGroup
 	if info.ReleaseGroup != "" {
-		conf += ReleaseGroupWeight
+		conf += w.ReleaseGroup
 	}
 	// Minor fields (1 point each)
 	if info.Episode != 0 {
-		conf += MinorFieldWeight
+		conf += w.MinorField
 	}
 	if info.Codec != "" {
-		conf += MinorFieldWeight
+		conf += w.MinorField
+	}
+	if info.DiscType != "" {
+		conf += w.MinorField
+	}
+	if info.Is3D {
+		conf += w.MinorField
 	}
 	if info.Audio != "" {
-		conf += MinorFieldWeight
+		conf += w.MinorField
 	}
 	if info.Container != "" {
-		conf += MinorFieldWeight
+		conf += w.MinorField
 	}
 	if info.Language != "" {
-		conf += MinorFieldWeight
+		conf += w.MinorField
 	}
 	if info.Edition != "" {
-		conf += MinorFieldWeight
+		conf += w.MinorField
 	}
 	if info.IsComplete {
-		conf += MinorFieldWeight
+		conf += w.MinorField
+	}
+	if info.IsMiniseries {
+		conf += w.MinorField
+	}
+	if info.FrameRate != 0 {
+		conf += w.MinorField
+	}
+	if info.HDR != "" {
+		conf += w.MinorField
+	}
+	if info.Volume != 0 {
+		conf += w.MinorField
+	}
+	if info.Part != 0 {
+		conf += w.MinorField
 	}
 	if info.IsProper {
-		conf += MinorFieldWeight
+		conf += w.MinorField
 	}
 	if info.IsRepack {
-		conf += MinorFieldWeight
+		conf += w.MinorField
 	}
 	if info.IsHardcoded {
-		conf += MinorFieldWeight
+		conf += w.MinorField
+	}
+	if info.IsDubbed {
+		conf += w.MinorField
 	}
 
 	// Ensure confidence is within valid bounds [0, 100]
@@ -916,24 +2511,116 @@ func (info *TorrentInfo) calculateConfidence() {
 	info.Confidence = conf
 }
 
+// Per-field confidence levels reported in TorrentInfo.FieldConfidence
+const (
+	sureField      = 0.9 // Delimited by an unambiguous token (S01E02, a lone year)
+	likelyField    = 0.7 // A weaker form (1x02, Specials) or leftovers that may belong to it
+	uncertainField = 0.5 // Could as well be part of another field (a year-like title word)
+)
+
+// setFieldConfidence scores Title, Year, Season and Episode from how clearly
+// name delimits them; hasLeftover reports words after the title of a movie or
+// pack that aren't metadata. Fields that are not set get no entry.
+func (info *TorrentInfo) setFieldConfidence(name string, hasLeftover bool) {
+	fc := make(map[string]float64, 4)
+
+	if info.Title != "" {
+		switch {
+		case len(info.Title) < 2 || strings.Trim(info.Title, "0123456789 ") == "":
+			fc["Title"] = uncertainField
+		case hasLeftover || len(info.Ignored) > 0:
+			// Leftover or duplicate words may be title words read as metadata
+			fc["Title"] = likelyField
+		case info.Year != 0 && yearPattern.MatchString(info.Title):
+			// The year-like title word may be the release year instead
+			fc["Title"] = likelyField
+		case info.YearEnd != 0:
+			// The first year of a pack may be a year-like title word instead
+			fc["Title"] = likelyField
+		default:
+			fc["Title"] = sureField
+		}
+	}
+
+	if info.Year != 0 {
+		switch {
+		case info.Date != "":
+			fc["Year"] = sureField
+		case yearPattern.MatchString(info.Title):
+			// "2012.2009": either number could be the release year
+			fc["Year"] = uncertainField
+		case containsYear(info.Ignored) || info.YearEnd != 0:
+			fc["Year"] = likelyField
+		default:
+			fc["Year"] = sureField
+		}
+	}
+
+	if info.HasSeason {
+		switch {
+		case seasonPattern.MatchString(name) || seasonAltPattern.MatchString(name) || episodePattern.MatchString(name):
+			fc["Season"] = sureField
+		case altEpisodePattern.MatchString(name) || specialsPattern.MatchString(name):
+			fc["Season"] = likelyField
+		default:
+			fc["Season"] = uncertainField
+		}
+	}
+
+	if info.Episode != 0 {
+		switch {
+		case episodePattern.MatchString(name):
+			fc["Episode"] = sureField
+		case altEpisodePattern.MatchString(name):
+			fc["Episode"] = likelyField
+		default:
+			fc["Episode"] = uncertainField
+		}
+	}
+
+	if len(fc) > 0 {
+		info.FieldConfidence = fc
+	}
+}
+
+// containsYear reports whether any of tokens is a year
+func containsYear(tokens []string) bool {
+	for _, t := range tokens {
+		if yearPattern.MatchString(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// englishStopwords are the common words NormalizeTitle drops
+var englishStopwords = map[string]bool{"the": true, "a": true, "an": true, "and": true, "or": true, "of": true}
+
 // NormalizeTitle removes common variations for matching
 func NormalizeTitle(title string) string {
+	return NormalizeTitleWith(title, englishStopwords)
+}
+
+// NormalizeTitleWith is NormalizeTitle with a caller-supplied stopword set.
+// Stopwords are matched against lowercased words; a nil or empty set keeps every word.
+func NormalizeTitleWith(title string, stopwords map[string]bool) string {
 	// Input validation
 	if title == "" {
 		return ""
 	}
 
-	// Replace all non-alphanumeric characters with spaces
-	title = regexp.MustCompile(`[^a-zA-Z0-9\s]`).ReplaceAllString(title, " ")
+	// Replace everything but letters and digits in any script with spaces,
+	// so CJK and accented titles survive normalization
+	title = nonWordPattern.ReplaceAllString(title, " ")
 
-	// Convert to lowercase and split into words
+	// Convert to lowercase and split into words; Fields splits on any Unicode
+	// white space, so no-break spaces separate words like NormalizeWhitespace
 	words := strings.Fields(strings.ToLower(title))
 
-	// Remove common words
-	commonWords := map[string]bool{"the": true, "a": true, "an": true, "and": true, "or": true, "of": true}
+	// Remove stopwords
 	filtered := []string{}
 	for _, word := range words {
-		if !commonWords[word] {
+		if !stopwords[word] {
 			filtered = append(filtered, word)
 		}
 	}
@@ -941,14 +2628,44 @@ func NormalizeTitle(title string) string {
 	return strings.Join(filtered, " ")
 }
 
+// Slug returns a lowercase, filesystem-safe name for the parsed content, such as
+// "the-matrix-1999" or "breaking-bad-s01e01". The year is included when known and
+// TV releases get an sNN or sNNeNN suffix. An empty Title yields "".
+func (info *TorrentInfo) Slug() string {
+	if info.Title == "" {
+		return ""
+	}
+
+	parts := []string{info.Title}
+	if info.Year != 0 {
+		parts = append(parts, strconv.Itoa(info.Year))
+	}
+	if info.HasSeason {
+		code := fmt.Sprintf("s%02d", info.Season)
+		if info.Episode != 0 {
+			code += fmt.Sprintf("e%02d", info.Episode)
+		}
+		parts = append(parts, code)
+	}
+
+	slug := strings.ToLower(strings.Join(parts, " "))
+	slug = slugSeparatorPattern.ReplaceAllString(slug, "-")
+	return strings.Trim(slug, "-")
+}
+
 // Recommended threshold for title matching using Dice coefficient.
 // Titles with similarity >= this value are considered a match.
 const TitleMatchThreshold = 0.8
 
 // MatchTitles checks if two titles likely refer to the same content.
 // Uses Dice coefficient for similarity and TitleMatchThreshold as the default threshold for a match.
+// Two empty titles (say, of two blank names) match; an empty title matches
+// nothing else.
 func MatchTitles(title1, title2 string, threshold float64) bool {
 	// Input validation
+	if title1 == "" && title2 == "" {
+		return true
+	}
 	if title1 == "" || title2 == "" {
 		return false
 	}
@@ -1001,3 +2718,53 @@ func calculateSimilarity(s1, s2 string) float64 {
 
 	return 2.0 * float64(intersection) / float64(total)
 }
+
+// TitleSimilarityWeighted compares two titles like MatchTitles' Dice coefficient,
+// but weights each word by its inverse document frequency in corpus, so words
+// shared by many corpus titles (franchise names such as "Star Wars") count less
+// than rarer ones. Titles are normalized with NormalizeTitle first. With an
+// empty corpus every word weighs the same and the result is the plain Dice
+// coefficient.
+func TitleSimilarityWeighted(a, b string, corpus []string) float64 {
+	// Count the corpus titles each word appears in
+	docFreq := make(map[string]int)
+	for _, title := range corpus {
+		for w := range wordSet(NormalizeTitle(title)) {
+			docFreq[w]++
+		}
+	}
+
+	// Smoothed IDF; always positive so unseen words still count
+	n := float64(len(corpus))
+	weight := func(w string) float64 {
+		return math.Log((n+1)/(float64(docFreq[w])+1)) + 1
+	}
+
+	set1 := wordSet(NormalizeTitle(a))
+	set2 := wordSet(NormalizeTitle(b))
+
+	var shared, total float64
+	for w := range set1 {
+		total += weight(w)
+		if set2[w] {
+			shared += weight(w)
+		}
+	}
+	for w := range set2 {
+		total += weight(w)
+	}
+	if total == 0 {
+		return 0
+	}
+
+	return 2.0 * shared / total
+}
+
+// wordSet returns the set of whitespace-separated words in s
+func wordSet(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(s) {
+		set[w] = true
+	}
+	return set
+}
@@ -1,8 +1,10 @@
 package torrentname
 
 import (
+	"math"
 	"reflect"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestParse(t *testing.T) {
@@ -49,6 +51,7 @@ func TestParse(t *testing.T) {
 				Source:       "BluRay",
 				Codec:        "H264",
 				ReleaseGroup: "ROVERS",
+				IndexerTag:   "rartv",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
@@ -75,7 +78,7 @@ func TestParse(t *testing.T) {
 				Resolution:   "2160p",
 				Source:       "BluRay",
 				Codec:        "H265",
-				Audio:        "TRUEHD 7.1 ATMOS",
+				Audio:        "TrueHD 7.1 Atmos",
 				ReleaseGroup: "COASTER",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
@@ -116,6 +119,7 @@ func TestParse(t *testing.T) {
 				Title:        "Parasite",
 				Year:         2019,
 				Language:     "Korean",
+				Languages:    []string{"Korean"},
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
@@ -128,110 +132,98 @@ func TestParse(t *testing.T) {
 			name:  "alternative episode format",
 			input: "House.1x01.Pilot.720p.HDTV.x264",
 			expected: &TorrentInfo{
-				Title:      "House",
-				Season:     1,
-				Episode:    1,
-				Resolution: "720p",
-				Source:     "HDTV",
-				Codec:      "H264",
-				Unparsed:   "Pilot",
-				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight + MinorFieldWeight,
-			},
-		},
-		{
-			name:  "cam release",
-			input: "Avengers.Endgame.2019.CAM.x264-ETRG",
-			expected: &TorrentInfo{
-				Title:        "Avengers Endgame",
-				Year:         2019,
-				Source:       "CAM",
+				Title:        "House",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "720p",
+				Source:       "HDTV",
 				Codec:        "H264",
-				ReleaseGroup: "ETRG",
-				Confidence:   YearSeasonWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				EpisodeTitle: "Pilot",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "repack release",
-			input: "The.Witcher.S01E01.REPACK.1080p.WEB.H264-METCON",
+			name:  "word-form season range",
+			input: "Breaking.Bad.Seasons.1-5.COMPLETE.1080p.BluRay-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Witcher",
-				Season:       1,
-				Episode:      1,
-				IsRepack:     true,
-				Resolution:   "1080p",
-				Source:       "WEBRip",
-				Codec:        "H264",
-				ReleaseGroup: "METCON",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+				Title:            "Breaking Bad",
+				Season:           1,
+				SeasonEnd:        5,
+				Resolution:       "1080p",
+				Source:           "BluRay",
+				ReleaseGroup:     "GROUP",
+				IsComplete:       true,
+				IsCompleteSeries: true,
+				Confidence:       YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with year in title",
-			input: "2001.A.Space.Odyssey.1968.1080p.BluRay.x264-SPARKS",
+			name:  "word-form season range with to",
+			input: "Breaking Bad Season 1 to 3 1080p BluRay-GROUP",
 			expected: &TorrentInfo{
-				Title:        "2001 A Space Odyssey",
-				Year:         1968,
+				Title:        "Breaking Bad",
+				Season:       1,
+				SeasonEnd:    3,
 				Resolution:   "1080p",
 				Source:       "BluRay",
-				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
 			},
 		},
 		{
-			name:  "movie titled 1941 released in 1979",
-			input: "1941.1979.1080p.BluRay.x264-SPARKS",
+			name:  "word-form single season",
+			input: "Show.Season.2.720p.HDTV-GROUP",
 			expected: &TorrentInfo{
-				Title:        "1941",
-				Year:         1979,
-				Resolution:   "1080p",
-				Source:       "BluRay",
-				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				Title:        "Show",
+				Season:       2,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
 			},
 		},
 		{
-			name:  "movie titled 1984 with no release year",
-			input: "1984.1080p.BluRay.x264-SPARKS",
+			name:  "full disc capacity tag",
+			input: "Movie.2021.UHD100.BluRay-GROUP",
 			expected: &TorrentInfo{
-				Title:        "1984",
-				Resolution:   "1080p",
+				Title:        "Movie",
+				Year:         2021,
 				Source:       "BluRay",
-				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				DiscType:     "UHD100",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "title not containing year, release year after metadata start",
-			input: "The.Matrix.1080p.BluRay.1999.x264-SPARKS",
+			name:  "bd50 disc",
+			input: "Movie 2010 1080p BD50 AVC DTS-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Matrix",
-				Year:         1999,
+				Title:        "Movie",
+				Year:         2010,
 				Resolution:   "1080p",
-				Source:       "BluRay",
+				DiscType:     "BD50",
 				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				Audio:        "DTS",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "title containing year, release year after metadata start",
-			input: "2001.A.Space.Odyssey.1080p.BluRay.1968.x264-SPARKS",
+			name:  "cjk title",
+			input: "进击的巨人.S01E01.1080p.WEB-DL-GROUP",
 			expected: &TorrentInfo{
-				Title:        "2001 A Space Odyssey",
-				Year:         1968,
+				Title:        "进击的巨人",
+				Season:       1,
+				Episode:      1,
 				Resolution:   "1080p",
-				Source:       "BluRay",
-				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "title not containing year, release year immediately after title",
-			input: "The.Matrix.1999.1080p.BluRay.x264-SPARKS",
+			name:  "no-break spaces",
+			input: "The\u00a0Matrix\u00a01999\u00a01080p\u00a0BluRay\u00a0x264-SPARKS",
 			expected: &TorrentInfo{
 				Title:        "The Matrix",
 				Year:         1999,
@@ -243,267 +235,314 @@ func TestParse(t *testing.T) {
 			},
 		},
 		{
-			name:  "title containing but not ending in year, release year immediately after title",
-			input: "2001.A.Space.Odyssey.1968.1080p.BluRay.x264-SPARKS",
+			name:  "3d with layout and resolution",
+			input: "Movie.2009.3D.1080p.HSBS.BluRay.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "2001 A Space Odyssey",
-				Year:         1968,
+				Title:        "Movie",
+				Year:         2009,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Is3D:         true,
+				ThreeDLayout: "HSBS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "title not containing year, no release year",
-			input: "Some.Movie.1080p.BluRay.x264-SPARKS",
+			name:  "3d ending a title without a year",
+			input: "Step.Up.3D.1080p.BluRay-GROUP",
 			expected: &TorrentInfo{
-				Title:        "Some Movie",
+				Title:        "Step Up 3D",
 				Resolution:   "1080p",
 				Source:       "BluRay",
-				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight,
 			},
 		},
 		{
-			name:  "title containing but not ending in year, no release year",
-			input: "The.Year.2000.Problem.1080p.BluRay.x264-SPARKS",
+			name:  "3d layout without 3d tag",
+			input: "Avatar.2009.1080p.BluRay.Half-OU.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Year 2000 Problem",
+				Title:        "Avatar",
+				Year:         2009,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Is3D:         true,
+				ThreeDLayout: "HOU",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "title ending in non-release year (before 1895), no release year",
-			input: "The.Year.1800.1080p.BluRay.x264-SPARKS",
+			name:  "3d in title",
+			input: "Step.Up.3D.2010.1080p.BluRay.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Year 1800",
+				Title:        "Step Up 3D",
+				Year:         2010,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "title ending in non-release year (far future), no release year",
-			input: "The.Year.3000.1080p.BluRay.x264-SPARKS",
+			name:  "real proper",
+			input: "Movie.2020.REAL.PROPER.1080p.BluRay.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Year 3000",
+				Title:        "Movie",
+				Year:         2020,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
-			},
-		},
-		{
-			name:  "hardcoded subtitles",
-			input: "Squid.Game.S01E01.HC.1080p.WEBRip",
-			expected: &TorrentInfo{
-				Title:       "Squid Game",
-				Season:      1,
-				Episode:     1,
-				IsHardcoded: true,
-				Resolution:  "1080p",
-				Source:      "WEBRip",
-				Confidence:  YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				IsProper:     true,
+				SceneTags:    []string{"REAL"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "daily show format",
-			input: "The.Daily.Show.2023.10.15.1080p.WEB",
+			name:  "scene tags after the metadata",
+			input: "Movie.2020.1080p.WEB.x264.iNTERNAL.RERIP-GROUP",
 			expected: &TorrentInfo{
-				Title:      "The Daily Show",
-				Year:       2023,
-				Date:       "2023.10.15",
-				Resolution: "1080p",
-				Source:     "WEBRip",
-				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight,
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				SceneTags:    []string{"INTERNAL", "RERIP"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "no dots separator",
-			input: "The Matrix 1999 1080p BluRay x264-SPARKS",
+			name:  "real in title",
+			input: "Keeping.It.Real.2020.1080p.BluRay-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Matrix",
-				Year:         1999,
+				Title:        "Keeping It Real",
+				Year:         2020,
 				Resolution:   "1080p",
 				Source:       "BluRay",
-				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
 			},
 		},
 		{
-			name:  "mixed case",
-			input: "tHe.MaTrIx.1999.1080P.bLuRaY.X264-SPARKS",
+			name:  "audio bit depth and sample rate",
+			input: "Concert.2019.1080p.BluRay.FLAC.24bit.96kHz-GROUP",
 			expected: &TorrentInfo{
-				Title:        "tHe MaTrIx",
-				Year:         1999,
-				Resolution:   "1080p",
-				Source:       "BluRay",
-				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				Title:           "Concert",
+				Year:            2019,
+				Resolution:      "1080p",
+				Source:          "BluRay",
+				Audio:           "FLAC",
+				AudioBitDepth:   "24bit",
+				AudioSampleRate: "96kHz",
+				ReleaseGroup:    "GROUP",
+				Confidence:      YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "mp4 container",
-			input: "Avengers.Endgame.2019.1080p.BluRay.x264-SPARKS.mp4",
+			name:  "audio bitrate next to channels",
+			input: "Concert.2019.1080p.BluRay.DD5.1.640Kbps.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "Avengers Endgame",
+				Title:        "Concert",
 				Year:         2019,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				Container:    "mp4",
-				ReleaseGroup: "SPARKS",
+				Audio:        "DD 5.1",
+				AudioBitrate: "640Kbps",
+				ReleaseGroup: "GROUP",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "avi container",
-			input: "Some.Movie.2000.720p.HDTV.x264.avi",
+			name:  "multi subtitles",
+			input: "Movie.2019.1080p.BluRay.MULTi.SUBS-GROUP",
 			expected: &TorrentInfo{
-				Title:      "Some Movie",
-				Year:       2000,
-				Resolution: "720p",
-				Source:     "HDTV",
-				Codec:      "H264",
-				Container:  "avi",
-				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight + MinorFieldWeight,
+				Title:        "Movie",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Subtitles:    []string{"Multi"},
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
 			},
 		},
 		{
-			name:  "movie with 'Show' in title",
-			input: "The.Daily.Show.2023.10.15.1080p.WEB",
+			name:  "multi audio with separate subtitles",
+			input: "Movie.2019.MULTi.1080p.BluRay.SUBS-GROUP",
 			expected: &TorrentInfo{
-				Title:      "The Daily Show",
-				Year:       2023,
-				Date:       "2023.10.15",
-				Resolution: "1080p",
-				Source:     "WEBRip",
-				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight,
+				Title:        "Movie",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Language:     "Multi",
+				Languages:    []string{"Multi"},
+				Subtitles:    []string{"Unknown"},
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Extended' in title",
-			input: "The.Lord.of.the.Rings.The.Fellowship.of.the.Ring.2001.EXTENDED.1080p.BluRay.x265-RARBG",
+			name:  "dotted web-dl",
+			input: "Show.S01E01.1080p.WEB.DL.DDP5.1-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Lord of the Rings The Fellowship of the Ring",
-				Year:         2001,
-				Edition:      "Extended",
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
 				Resolution:   "1080p",
-				Source:       "BluRay",
-				Codec:        "H265",
-				ReleaseGroup: "RARBG",
+				Source:       "WEB-DL",
+				Audio:        "DDP 5.1",
+				ReleaseGroup: "GROUP",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Complete' in title",
-			input: "The.Complete.Works.of.Shakespeare.1995.1080p.BluRay.x264-SPARKS",
+			name:  "spaced web-dl",
+			input: "Movie 2020 1080p WEB DL x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Complete Works of Shakespeare",
-				Year:         1995,
+				Title:        "Movie",
+				Year:         2020,
 				Resolution:   "1080p",
-				Source:       "BluRay",
+				Source:       "WEB-DL",
 				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
+				ReleaseGroup: "GROUP",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Proper' in title",
-			input: "The.Proper.Way.to.Cook.2010.720p.HDTV.x264-ROVERS",
+			name:  "pdtv broadcast capture",
+			input: "Show.S05E12.PDTV.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "The Proper Way to Cook",
-				Year:         2010,
-				Resolution:   "720p",
-				Source:       "HDTV",
+				Title:        "Show",
+				Season:       5,
+				Episode:      12,
+				Source:       "PDTV",
 				Codec:        "H264",
-				ReleaseGroup: "ROVERS",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Repack' in title",
-			input: "How.to.Repack.Your.Bags.2015.1080p.WEB-DL.x264-FGT",
+			name:  "satellite rip normalized to DSR",
+			input: "Show.S02E03.DSRip.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "How to Repack Your Bags",
-				Year:         2015,
-				Resolution:   "1080p",
-				Source:       "WEB-DL",
+				Title:        "Show",
+				Season:       2,
+				Episode:      3,
+				Source:       "DSR",
 				Codec:        "H264",
-				ReleaseGroup: "FGT",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Unrated' in title",
-			input: "The.Unrated.Story.2008.720p.BluRay.x264-SPARKS",
+			name:  "cam release",
+			input: "Avengers.Endgame.2019.CAM.x264-ETRG",
 			expected: &TorrentInfo{
-				Title:        "The Unrated Story",
-				Year:         2008,
-				Resolution:   "720p",
-				Source:       "BluRay",
+				Title:        "Avengers Endgame",
+				Year:         2019,
+				Source:       "CAM",
 				Codec:        "H264",
-				ReleaseGroup: "SPARKS",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				ReleaseGroup: "ETRG",
+				Confidence:   YearSeasonWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Theatrical' in title",
-			input: "The.Theatrical.Experience.2012.1080p.WEBRip.x265-RARBG",
+			name:  "proper after release group",
+			input: "Show.S01E01.1080p.WEB.H264-GROUP.PROPER",
 			expected: &TorrentInfo{
-				Title:        "The Theatrical Experience",
-				Year:         2012,
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				IsProper:     true,
 				Resolution:   "1080p",
-				Source:       "WEBRip",
-				Codec:        "H265",
-				ReleaseGroup: "RARBG",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+				Source:       "WEB",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Directors' in title",
-			input: "The.Directors.Guild.2019.720p.HDTV.x264-ETRG",
+			name:  "repack after release group with container",
+			input: "Movie.2019.1080p.BluRay.x264-GROUP-REPACK.mkv",
 			expected: &TorrentInfo{
-				Title:        "The Directors Guild",
+				Title:        "Movie",
 				Year:         2019,
-				Resolution:   "720p",
-				Source:       "HDTV",
+				IsRepack:     true,
+				Resolution:   "1080p",
+				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "ETRG",
+				Container:    "mkv",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "bluray remux",
+			input: "Movie.2019.2160p.BluRay.REMUX.HEVC.TrueHD.7.1.Atmos-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				Resolution:   "2160p",
+				Source:       "REMUX",
+				Codec:        "H265",
+				Audio:        "TrueHD 7.1 Atmos",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "hyphenated audio codec",
+			input: "Movie.2019.1080p.WEB-DL.E-AC-3.5.1-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				Audio:        "EAC3 5.1",
+				ReleaseGroup: "GROUP",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Cut' in title",
-			input: "The.Cut.Throat.Business.2017.1080p.BluRay.x264-COASTER",
+			name:  "repack release",
+			input: "The.Witcher.S01E01.REPACK.1080p.WEB.H264-METCON",
 			expected: &TorrentInfo{
-				Title:        "The Cut Throat Business",
-				Year:         2017,
+				Title:        "The Witcher",
+				Season:       1,
+				Episode:      1,
+				IsRepack:     true,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				Codec:        "H264",
+				ReleaseGroup: "METCON",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with year in title",
+			input: "2001.A.Space.Odyssey.1968.1080p.BluRay.x264-SPARKS",
+			expected: &TorrentInfo{
+				Title:        "2001 A Space Odyssey",
+				Year:         1968,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "COASTER",
+				ReleaseGroup: "SPARKS",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 'Final' in title",
-			input: "The.Final.Countdown.1980.1080p.BluRay.x264-SPARKS",
+			name:  "movie titled 1941 released in 1979",
+			input: "1941.1979.1080p.BluRay.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:        "The Final Countdown",
-				Year:         1980,
+				Title:        "1941",
+				Year:         1979,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
@@ -512,258 +551,2981 @@ func TestParse(t *testing.T) {
 			},
 		},
 		{
-			name:  "minimal info low confidence",
-			input: "Some Movie",
+			name:  "movie titled 2012 released in 2009",
+			input: "2012.2009.1080p.BluRay.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:      "Some Movie",
-				Confidence: 0,
+				Title:        "2012",
+				Year:         2009,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "medium info medium confidence",
-			input: "Avatar.2009.1080p",
+			name:  "movie titled 1917 released in 2019",
+			input: "1917.2019.1080p.BluRay.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:      "Avatar",
-				Year:       2009,
-				Resolution: "1080p",
-				Confidence: YearSeasonWeight + ResolutionWeight,
+				Title:        "1917",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "BTN season pack format",
-			input: "Breaking.Bad.S01.Complete.720p.BluRay.x264-DEMAND",
+			name:  "movie titled 300 released in 2006",
+			input: "300.2006.1080p.BluRay.x264-GROUP",
 			expected: &TorrentInfo{
-				Title:        "Breaking Bad",
-				Season:       1,
-				IsComplete:   true,
-				Resolution:   "720p",
+				Title:        "300",
+				Year:         2006,
+				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "DEMAND",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "HDBits format",
-			input: "The.Dark.Knight.2008.1080p.BluRay.DTS.x264-ESiR",
+			name:  "movie titled 1984 with no release year",
+			input: "1984.1080p.BluRay.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:        "The Dark Knight",
-				Year:         2008,
+				Title:        "1984",
 				Resolution:   "1080p",
 				Source:       "BluRay",
-				Audio:        "DTS",
 				Codec:        "H264",
-				ReleaseGroup: "ESiR",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+				ReleaseGroup: "SPARKS",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "year before and after metadata start",
-			input: "Movie.1995.1080p.2010.BluRay.x264-GROUP",
+			name:  "title not containing year, release year after metadata start",
+			input: "The.Matrix.1080p.BluRay.1999.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:        "Movie 1995",
-				Year:         2010,
+				Title:        "The Matrix",
+				Year:         1999,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "GROUP",
+				ReleaseGroup: "SPARKS",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "edition before and after metadata start",
-			input: "Epic.Film.Extended.1080p.THEATRICAL.BluRay.x264-GROUP",
+			name:  "title containing year, release year after metadata start",
+			input: "2001.A.Space.Odyssey.1080p.BluRay.1968.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:        "Epic Film Extended",
-				Edition:      "Theatrical",
+				Title:        "2001 A Space Odyssey",
+				Year:         1968,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "GROUP",
-				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "keyword before and after metadata start",
-			input: "Show.Complete.720p.PROPER.HDTV.x264-GROUP",
+			name:  "title not containing year, release year immediately after title",
+			input: "The.Matrix.1999.1080p.BluRay.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:        "Show",
-				IsComplete:   true,
-				IsProper:     true,
-				Resolution:   "720p",
-				Source:       "HDTV",
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "GROUP",
-				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "title contains year, real year after metadata start",
-			input: "The.Year.2000.Problem.1080p.2001.BluRay.x264-GROUP",
+			name:  "title containing but not ending in year, release year immediately after title",
+			input: "2001.A.Space.Odyssey.1968.1080p.BluRay.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:        "The Year 2000 Problem",
-				Year:         2001,
+				Title:        "2001 A Space Odyssey",
+				Year:         1968,
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "GROUP",
+				ReleaseGroup: "SPARKS",
 				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "multi-word_edition_in_title,_real_edition_after_metadata_start",
-			input: "Aliens.Directors.Cut.1080p.FINAL.CUT.BluRay.x264-GROUP",
+			name:  "title not containing year, no release year",
+			input: "Some.Movie.1080p.BluRay.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:        "Aliens Directors Cut",
-				Edition:      "Final Cut",
+				Title:        "Some Movie",
 				Resolution:   "1080p",
 				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "GROUP",
-				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+				ReleaseGroup: "SPARKS",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "tv episode with unparsed title",
-			input: "Breaking Bad S01E01 Pilot 1080p",
+			name:  "title containing but not ending in year, no release year",
+			input: "The.Year.2000.Problem.1080p.BluRay.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:      "Breaking Bad",
-				Season:     1,
-				Episode:    1,
-				Resolution: "1080p",
-				Unparsed:   "Pilot",
-				Confidence: YearSeasonWeight + ResolutionWeight + MinorFieldWeight,
+				Title:        "The Year 2000 Problem",
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "movie with 2.0 in title and audio metadata",
-			input: "Godzilla 2.0 1080p TrueHD 7.1 Atmos",
+			name:  "title ending in non-release year (before 1895), no release year",
+			input: "The.Year.1800.1080p.BluRay.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:      "Godzilla 2 0",
-				Resolution: "1080p",
-				Audio:      "TRUEHD 7.1 ATMOS",
-				Confidence: ResolutionWeight + MinorFieldWeight,
+				Title:        "The Year 1800",
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "mono audio after metadata boundary",
-			input: "Classic.Movie.1950.480p.DVD.Mono.x264-GROUP",
+			name:  "title ending in non-release year (far future), no release year",
+			input: "The.Year.3000.1080p.BluRay.x264-SPARKS",
 			expected: &TorrentInfo{
-				Title:        "Classic Movie",
-				Year:         1950,
-				Resolution:   "480p",
-				Source:       "DVD",
-				Audio:        "MONO",
+				Title:        "The Year 3000",
+				Resolution:   "1080p",
+				Source:       "BluRay",
 				Codec:        "H264",
-				ReleaseGroup: "GROUP",
-				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+				ReleaseGroup: "SPARKS",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
 		{
-			name:  "duplicate definitely metadata",
-			input: "Some.Movie.2020.1080p.720p.BluRay.WEB.x264.H265-GROUP",
+			name:  "hardcoded subtitles",
+			input: "Squid.Game.S01E01.HC.1080p.WEBRip",
 			expected: &TorrentInfo{
-				Title:        "Some Movie 2020 1080p 720p BluRay WEB x264",
-				Codec:        "H265", // First codec found (back-to-front scan)
-				ReleaseGroup: "GROUP",
-				Confidence:   ReleaseGroupWeight + MinorFieldWeight,
+				Title:       "Squid Game",
+				Season:      1,
+				Episode:     1,
+				IsHardcoded: true,
+				Resolution:  "1080p",
+				Source:      "WEBRip",
+				Confidence:  YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "daily show format",
+			input: "The.Daily.Show.2023.10.15.1080p.WEB",
+			expected: &TorrentInfo{
+				Title:      "The Daily Show",
+				Year:       2023,
+				Date:       "2023.10.15",
+				Resolution: "1080p",
+				Source:     "WEB",
+				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight,
+			},
+		},
+		{
+			name:  "no dots separator",
+			input: "The Matrix 1999 1080p BluRay x264-SPARKS",
+			expected: &TorrentInfo{
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
 			},
 		},
+		{
+			name:  "underscores and dots mixed",
+			input: "The_Matrix.1999_1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "underscore separators",
+			input: "The_Matrix_1999_1080p_BluRay_x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "underscore separated tv episode",
+			input: "Show_S01E01_Pilot_Part_720p_HDTV-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				ReleaseGroup: "GROUP",
+				EpisodeTitle: "Pilot Part",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "mixed case",
+			input: "tHe.MaTrIx.1999.1080P.bLuRaY.X264-SPARKS",
+			expected: &TorrentInfo{
+				Title:        "tHe MaTrIx",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "mp4 container",
+			input: "Avengers.Endgame.2019.1080p.BluRay.x264-SPARKS.mp4",
+			expected: &TorrentInfo{
+				Title:        "Avengers Endgame",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				Container:    "mp4",
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "avi container",
+			input: "Some.Movie.2000.720p.HDTV.x264.avi",
+			expected: &TorrentInfo{
+				Title:      "Some Movie",
+				Year:       2000,
+				Resolution: "720p",
+				Source:     "HDTV",
+				Codec:      "H264",
+				Container:  "avi",
+				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Show' in title",
+			input: "The.Daily.Show.2023.10.15.1080p.WEB",
+			expected: &TorrentInfo{
+				Title:      "The Daily Show",
+				Year:       2023,
+				Date:       "2023.10.15",
+				Resolution: "1080p",
+				Source:     "WEB",
+				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight,
+			},
+		},
+		{
+			name:  "movie with 'Extended' in title",
+			input: "The.Lord.of.the.Rings.The.Fellowship.of.the.Ring.2001.EXTENDED.1080p.BluRay.x265-RARBG",
+			expected: &TorrentInfo{
+				Title:        "The Lord of the Rings The Fellowship of the Ring",
+				Year:         2001,
+				Edition:      "Extended",
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H265",
+				ReleaseGroup: "RARBG",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Complete' in title",
+			input: "The.Complete.Works.of.Shakespeare.1995.1080p.BluRay.x264-SPARKS",
+			expected: &TorrentInfo{
+				Title:        "The Complete Works of Shakespeare",
+				Year:         1995,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Proper' in title",
+			input: "The.Proper.Way.to.Cook.2010.720p.HDTV.x264-ROVERS",
+			expected: &TorrentInfo{
+				Title:        "The Proper Way to Cook",
+				Year:         2010,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				Codec:        "H264",
+				ReleaseGroup: "ROVERS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Repack' in title",
+			input: "How.to.Repack.Your.Bags.2015.1080p.WEB-DL.x264-FGT",
+			expected: &TorrentInfo{
+				Title:        "How to Repack Your Bags",
+				Year:         2015,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				Codec:        "H264",
+				ReleaseGroup: "FGT",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Unrated' in title",
+			input: "The.Unrated.Story.2008.720p.BluRay.x264-SPARKS",
+			expected: &TorrentInfo{
+				Title:        "The Unrated Story",
+				Year:         2008,
+				Resolution:   "720p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Theatrical' in title",
+			input: "The.Theatrical.Experience.2012.1080p.WEBRip.x265-RARBG",
+			expected: &TorrentInfo{
+				Title:        "The Theatrical Experience",
+				Year:         2012,
+				Resolution:   "1080p",
+				Source:       "WEBRip",
+				Codec:        "H265",
+				ReleaseGroup: "RARBG",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Directors' in title",
+			input: "The.Directors.Guild.2019.720p.HDTV.x264-ETRG",
+			expected: &TorrentInfo{
+				Title:        "The Directors Guild",
+				Year:         2019,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				Codec:        "H264",
+				ReleaseGroup: "ETRG",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Cut' in title",
+			input: "The.Cut.Throat.Business.2017.1080p.BluRay.x264-COASTER",
+			expected: &TorrentInfo{
+				Title:        "The Cut Throat Business",
+				Year:         2017,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "COASTER",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 'Final' in title",
+			input: "The.Final.Countdown.1980.1080p.BluRay.x264-SPARKS",
+			expected: &TorrentInfo{
+				Title:        "The Final Countdown",
+				Year:         1980,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "SPARKS",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "minimal info low confidence",
+			input: "Some Movie",
+			expected: &TorrentInfo{
+				Title:      "Some Movie",
+				Confidence: 0,
+			},
+		},
+		{
+			name:  "medium info medium confidence",
+			input: "Avatar.2009.1080p",
+			expected: &TorrentInfo{
+				Title:      "Avatar",
+				Year:       2009,
+				Resolution: "1080p",
+				Confidence: YearSeasonWeight + ResolutionWeight,
+			},
+		},
+		{
+			name:  "BTN season pack format",
+			input: "Breaking.Bad.S01.Complete.720p.BluRay.x264-DEMAND",
+			expected: &TorrentInfo{
+				Title:        "Breaking Bad",
+				Season:       1,
+				IsComplete:   true,
+				Resolution:   "720p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "DEMAND",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "HDBits format",
+			input: "The.Dark.Knight.2008.1080p.BluRay.DTS.x264-ESiR",
+			expected: &TorrentInfo{
+				Title:        "The Dark Knight",
+				Year:         2008,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Audio:        "DTS",
+				Codec:        "H264",
+				ReleaseGroup: "ESiR",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "year before and after metadata start",
+			input: "Movie.1995.1080p.2010.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie 1995",
+				Year:         2010,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "edition before and after metadata start",
+			input: "Epic.Film.Extended.1080p.THEATRICAL.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Epic Film Extended",
+				Edition:      "Theatrical",
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "keyword before and after metadata start",
+			input: "Show.Complete.720p.PROPER.HDTV.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:            "Show",
+				IsComplete:       true,
+				IsCompleteSeries: true,
+				IsProper:         true,
+				Resolution:       "720p",
+				Source:           "HDTV",
+				Codec:            "H264",
+				ReleaseGroup:     "GROUP",
+				Confidence:       ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "title contains year, real year after metadata start",
+			input: "The.Year.2000.Problem.1080p.2001.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "The Year 2000 Problem",
+				Year:         2001,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "multi-word_edition_in_title,_real_edition_after_metadata_start",
+			input: "Aliens.Directors.Cut.1080p.FINAL.CUT.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Aliens Directors Cut",
+				Edition:      "Final Cut",
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "tv episode with unparsed title",
+			input: "Breaking Bad S01E01 Pilot 1080p",
+			expected: &TorrentInfo{
+				Title:        "Breaking Bad",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				EpisodeTitle: "Pilot",
+				Confidence:   YearSeasonWeight + ResolutionWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "movie with 2.0 in title and audio metadata",
+			input: "Godzilla 2.0 1080p TrueHD 7.1 Atmos",
+			expected: &TorrentInfo{
+				Title:      "Godzilla 2 0",
+				Resolution: "1080p",
+				Audio:      "TrueHD 7.1 Atmos",
+				Confidence: ResolutionWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "mono audio after metadata boundary",
+			input: "Classic.Movie.1950.480p.DVD.Mono.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Classic Movie",
+				Year:         1950,
+				Resolution:   "480p",
+				Source:       "DVD",
+				Audio:        "Mono",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "complete series",
+			input: "Friends.COMPLETE.SERIES.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:            "Friends",
+				IsComplete:       true,
+				IsCompleteSeries: true,
+				Resolution:       "1080p",
+				Source:           "BluRay",
+				ReleaseGroup:     "GROUP",
+				Confidence:       ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "codec stacked with Dolby Vision and HDR",
+			input: "Show.S01E01.2160p.WEB-DL.HEVC.DV.HDR-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "2160p",
+				Source:       "WEB-DL",
+				Codec:        "H265",
+				HDR:          "Dolby Vision / HDR10",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "encoder followed by HDR10",
+			input: "Movie.2021.2160p.BluRay.x265.HDR10-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2021,
+				Resolution:   "2160p",
+				Source:       "BluRay",
+				Codec:        "H265",
+				HDR:          "HDR10",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "HDR10+ and DoVi",
+			input: "Movie.2021.2160p.WEB-DL.DoVi.HDR10+.x265-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2021,
+				Resolution:   "2160p",
+				Source:       "WEB-DL",
+				Codec:        "H265",
+				HDR:          "Dolby Vision / HDR10+",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "HDR before the resolution",
+			input: "Movie.2021.HDR.2160p.BluRay.x265-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2021,
+				Resolution:   "2160p",
+				Source:       "BluRay",
+				Codec:        "H265",
+				HDR:          "HDR10",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "repeated HDR format",
+			input: "Movie.2021.2160p.BluRay.HDR.HDR10.x265-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2021,
+				Resolution:   "2160p",
+				Source:       "BluRay",
+				Codec:        "H265",
+				HDR:          "HDR10",
+				ReleaseGroup: "GROUP",
+				Ignored:      []string{"HDR"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "DV in a title",
+			input: "The.DV.Files.S01E01.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "The DV Files",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "HDR starting a title",
+			input: "Hdr.Vision.S01E01.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Hdr Vision",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "FHD tag without line count",
+			input: "Movie.2020.FHD.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "bare HD is 720p",
+			input: "Show.S01E01.HD.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "720p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "UHD next to a line count",
+			input: "Movie.2021.2160p.UHD.BluRay.x265-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2021,
+				Resolution:   "2160p",
+				Source:       "BluRay",
+				Codec:        "H265",
+				ReleaseGroup: "GROUP",
+				Ignored:      []string{"UHD"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "UHD in a title before the episode code",
+			input: "Planet.Earth.UHD.S01E01.2160p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Planet Earth UHD",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "2160p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "HD as a title word",
+			input: "HD.Movie.2020.1080p.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "HD Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "frame rate glued to resolution",
+			input: "Sports.2023.1080p60.WEB-DL.H264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Sports",
+				Year:         2023,
+				Resolution:   "1080p",
+				FrameRate:    60,
+				Source:       "WEB-DL",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "fractional frame rate rounds to whole frames",
+			input: "Concert.2019.2160p23.976.WEB-DL.x265-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Concert",
+				Year:         2019,
+				Resolution:   "2160p",
+				FrameRate:    24,
+				Source:       "WEB-DL",
+				Codec:        "H265",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "50 fps broadcast episode",
+			input: "Show.S01E01.1080p50.HDTV.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				FrameRate:    50,
+				Source:       "HDTV",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "episode title with a resolution-like word",
+			input: "Show.S01E05.4K.Restoration.1080p.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      5,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				EpisodeTitle: "4K Restoration",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "episode title with a source-like word",
+			input: "Show.S01E05.The.BluRay.Years.1080p.WEB-DL.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      5,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				EpisodeTitle: "The BluRay Years",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "volume after the title",
+			input: "[Group] Show Vol.2 1080p BluRay FLAC-GRP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Volume:       2,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Audio:        "FLAC",
+				ReleaseGroup: "GRP",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "volume spelled out",
+			input: "[Group] Show Volume 3 1080p BluRay x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Volume:       3,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			// Outside anime-style names a volume ends the title
+			name:  "volume ending a title without a year",
+			input: "Guardians.of.the.Galaxy.Vol.2.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Guardians of the Galaxy Vol 2",
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "volume in a movie title",
+			input: "Kill.Bill.Vol.1.2003.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Kill Bill Vol 1",
+				Year:         2003,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "country code after a series title",
+			input: "The.Office.US.S01E01.720p.HDTV.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "The Office",
+				Country:      "US",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "UK remake",
+			input: "Shameless.UK.S01.1080p.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Shameless",
+				Country:      "UK",
+				Season:       1,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "country code in a movie title stays",
+			input: "Made.In.US.2019.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Made In US",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "all-caps title keeps US",
+			input: "THIS.IS.US.S01E01.720p.HDTV.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "THIS IS US",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "dotted initialism starting with S",
+			input: "S.W.A.T.2021.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "S W A T",
+				Year:         2021,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "digit inside a title word",
+			input: "Se7en.1995.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Se7en",
+				Year:         1995,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "S and digits ending a title word",
+			input: "MS1.2012.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "MS1",
+				Year:         2012,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "ISO language code",
+			input: "Movie.2019.ITA.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Language:     "Italian",
+				Languages:    []string{"Italian"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "language added from the table",
+			input: "Movie.2019.HINDI.1080p.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Language:     "Hindi",
+				Languages:    []string{"Hindi"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "lowercase code word in title",
+			input: "Top.Cat.2011.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Top Cat",
+				Year:         2011,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "capitalized code word before year",
+			input: "TOP.CAT.2011.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "TOP CAT",
+				Year:         2011,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "capitalized code word before an episode code",
+			input: "TOP.CAT.S01E01.720p.HDTV-GROUP",
+			expected: &TorrentInfo{
+				Title:        "TOP CAT",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "PROPER as the only metadata",
+			input: "Movie.PROPER",
+			expected: &TorrentInfo{
+				Title:      "Movie",
+				IsProper:   true,
+				Confidence: MinorFieldWeight,
+			},
+		},
+		{
+			name:  "REPACK as the only metadata",
+			input: "Show.REPACK",
+			expected: &TorrentInfo{
+				Title:      "Show",
+				IsRepack:   true,
+				Confidence: MinorFieldWeight,
+			},
+		},
+		{
+			name:  "COMPLETE as the only metadata",
+			input: "Title.COMPLETE",
+			expected: &TorrentInfo{
+				Title:            "Title",
+				IsComplete:       true,
+				IsCompleteSeries: true,
+				Confidence:       MinorFieldWeight,
+			},
+		},
+		{
+			name:  "trailing file size",
+			input: "Movie.2020.1080p.BluRay.x264-GROUP (1.4GB)",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				FileSize:     "1.4GB",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "file size after container and channels",
+			input: "Movie.2020.1080p.BluRay.AAC.5.1-GROUP.mkv [2.3 GiB]",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Audio:        "AAC 5.1",
+				ReleaseGroup: "GROUP",
+				Container:    "mkv",
+				FileSize:     "2.3 GiB",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "deleted scenes extra",
+			input: "Movie.2020.Deleted.Scenes.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				IsExtra:      true,
+				ExtraType:    "Deleted Scenes",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "extras word in movie title",
+			input: "The.Bonus.2019.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "The Bonus",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "extras word in series title",
+			input: "Trailer.Park.Boys.S01.1080p.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Trailer Park Boys",
+				Season:       1,
+				HasSeason:    true,
+				IsSeasonPack: true,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "extras word in episode title",
+			input: "Jeopardy.S01E03.Bonus.Round.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Jeopardy",
+				Season:       1,
+				Episode:      3,
+				EpisodeTitle: "Bonus Round",
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "extras word between metadata after an episode code",
+			input: "Show.S01E03.Bonus.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      3,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				IsExtra:      true,
+				ExtraType:    "Bonus",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "remaster year after the release year",
+			input: "Movie.1977.REMASTERED.2016.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         1977,
+				RemasterYear: 2016,
+				Edition:      "Remastered",
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "remastered without a year",
+			input: "Movie.1977.REMASTERED.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         1977,
+				Edition:      "Remastered",
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "source before resolution",
+			input: "Movie.2021.BluRay.2160p.HEVC-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2021,
+				Resolution:   "2160p",
+				Source:       "BluRay",
+				Codec:        "H265",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "source before resolution in an episode",
+			input: "Show.S01E01.WEB-DL.1080p.DDP5.1.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				Codec:        "H264",
+				Audio:        "DDP 5.1",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "specials pack",
+			input: "Doctor.Who.Specials.2013.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Doctor Who",
+				Year:         2013,
+				HasSeason:    true,
+				IsSeasonPack: true,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "specials after an article is a title",
+			input: "The.Specials.2019.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "The Specials",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "compound HDTVRip source",
+			input: "Movie.2019.HDTVRip.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				Source:       "HDTV",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "subtitle file with language",
+			input: "The.Matrix.1999.1080p.BluRay-GROUP.en.srt",
+			expected: &TorrentInfo{
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Container:    "srt",
+				IsSubtitle:   true,
+				Subtitles:    []string{"English"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "subtitle file with three-letter code",
+			input: "Show.S01E01.720p.HDTV-GRP.ger.ass",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				ReleaseGroup: "GRP",
+				Container:    "ass",
+				IsSubtitle:   true,
+				Subtitles:    []string{"German"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "subtitle file without language",
+			input: "The.Matrix.1999.1080p.BluRay-GROUP.srt",
+			expected: &TorrentInfo{
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Container:    "srt",
+				IsSubtitle:   true,
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "numeric token after the hyphen",
+			input: "Movie.2020.1080p.BluRay.x264-720",
+			expected: &TorrentInfo{
+				Title:      "Movie",
+				Year:       2020,
+				Resolution: "1080p",
+				Source:     "BluRay",
+				Codec:      "H264",
+				Ignored:    []string{"720"},
+				Confidence: YearSeasonWeight + ResolutionWeight + SourceWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "digits and letters after the hyphen",
+			input: "Movie.2020.1080p.BluRay.x264-3L",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "3L",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			// Two years are a year-like title and the release year
+			name:  "year-titled film and its release year",
+			input: "Wonder.Woman.1984.2020.1080p.WEB-DL.H264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Wonder Woman 1984",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "multi-year collection",
+			input: "Collection.2018.2019.2020.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Collection",
+				Year:         2018,
+				YearEnd:      2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "spelled-out part",
+			input: "Harry.Potter.Deathly.Hallows.Part.Two.2011.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Harry Potter Deathly Hallows",
+				Year:         2011,
+				Part:         2,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "disc number",
+			input: "Movie.2003.CD1.720p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2003,
+				Part:         1,
+				Resolution:   "720p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "part as a title word",
+			input: "Part.Time.Job.2019.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Part Time Job",
+				Year:         2019,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "indexer tag after the group",
+			input: "Breaking.Bad.S01E01.720p.HDTV.x264-ROVERS[rartv]",
+			expected: &TorrentInfo{
+				Title:        "Breaking Bad",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				Codec:        "H264",
+				ReleaseGroup: "ROVERS",
+				IndexerTag:   "rartv",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "spaced indexer tag with container",
+			input: "Movie.2020.1080p.WEB-DL-GROUP [rarbg].mkv",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				IndexerTag:   "rarbg",
+				Container:    "mkv",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "complete without a season",
+			input: "The.Sopranos.Complete.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:            "The Sopranos",
+				IsComplete:       true,
+				IsCompleteSeries: true,
+				Resolution:       "1080p",
+				Source:           "BluRay",
+				Codec:            "H264",
+				ReleaseGroup:     "GROUP",
+				Confidence:       ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "complete after a release year",
+			input: "Movie.2010.Complete.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2010,
+				IsComplete:   true,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "dubbed after the year",
+			input: "Movie.2020.DUBBED.1080p.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				IsDubbed:     true,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "subbed is not dubbed",
+			input: "Movie.2020.SUBBED.1080p.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Subtitles:    []string{"Unknown"},
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "PROPER where an episode title would be",
+			input: "Show.S01E01.PROPER.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				IsProper:     true,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "REPACK where an episode title would be",
+			input: "Show.S01E01.REPACK.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				IsRepack:     true,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "episode title followed by PROPER",
+			input: "Show.S01E01.Pilot.PROPER.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				EpisodeTitle: "Pilot",
+				IsProper:     true,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "group before a container",
+			input: "Movie.2020.1080p.WEB.x264-DON.mkv",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				Codec:        "H264",
+				ReleaseGroup: "DON",
+				Container:    "mkv",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "two groups joined by a hyphen",
+			input: "Movie.2020.1080p.BluRay.x264-DON-WiKi",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "DON-WiKi",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "obfuscation suffix after the group",
+			input: "Movie.2020.1080p.BluRay.x264-GROUP-Obfuscated",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "reposter suffix after the group",
+			input: "Show.S01E01.1080p.WEB.H264-NTb-postbot",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				HasSeason:    true,
+				Episode:      1,
+				EpisodeType:  "Episode",
+				Resolution:   "1080p",
+				Source:       "WEB",
+				Codec:        "H264",
+				ReleaseGroup: "NTb",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "group and uploader",
+			input: "Movie.2020.1080p.BluRay.x264-mSD-RARBG",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "mSD-RARBG",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "group with a hyphen in its name",
+			input: "Movie.2020.1080p.BluRay.x264-D-Z0N3",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "D-Z0N3",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "complete episode range",
+			input: "Show.S01.E01-E10.Complete.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				EpisodeEnd:   10,
+				EpisodeCount: 10,
+				IsComplete:   true,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "complete joined episode range",
+			input: "Show.S01E01-E10.Complete.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				EpisodeEnd:   10,
+				EpisodeCount: 10,
+				IsComplete:   true,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "number starting the title",
+			input: "10.Things.I.Hate.About.You.1999.1080p-GROUP",
+			expected: &TorrentInfo{
+				Title:        "10 Things I Hate About You",
+				Year:         1999,
+				Resolution:   "1080p",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "torrent file name with a container",
+			input: "Movie.2020.1080p.BluRay.x264-GROUP.mkv.torrent",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Container:    "mkv",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "torrent file name",
+			input: "Movie.2020.1080p.BluRay.x264-GROUP.torrent",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "full season",
+			input: "Show.S02.Full.Season.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       2,
+				IsComplete:   true,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "full series",
+			input: "Friends.Full.Series.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:            "Friends",
+				IsComplete:       true,
+				IsCompleteSeries: true,
+				Resolution:       "1080p",
+				Source:           "BluRay",
+				ReleaseGroup:     "GROUP",
+				Confidence:       ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "all episodes",
+			input: "Show.S03.All.Episodes.720p.HDTV.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       3,
+				IsComplete:   true,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "full as a title word",
+			input: "Full.Metal.Jacket.1987.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Full Metal Jacket",
+				Year:         1987,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "complete miniseries",
+			input: "Chernobyl.COMPLETE.Miniseries.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:            "Chernobyl",
+				IsComplete:       true,
+				IsCompleteSeries: true,
+				IsMiniseries:     true,
+				Resolution:       "1080p",
+				Source:           "BluRay",
+				ReleaseGroup:     "GROUP",
+				Confidence:       ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "hyphenated mini-series",
+			input: "Chernobyl.Mini-Series.1080p.BluRay-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Chernobyl",
+				IsMiniseries: true,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				ReleaseGroup: "GROUP",
+				Confidence:   ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "complete season range",
+			input: "Friends.S01-S10.COMPLETE.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:            "Friends",
+				Season:           1,
+				SeasonEnd:        10,
+				IsComplete:       true,
+				IsCompleteSeries: true,
+				Resolution:       "1080p",
+				Source:           "BluRay",
+				Codec:            "H264",
+				ReleaseGroup:     "GROUP",
+				Confidence:       YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "season range without complete",
+			input: "Friends.S01-S10.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Friends",
+				Season:       1,
+				SeasonEnd:    10,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "episode range",
+			input: "Show.S01E01-E10.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				EpisodeEnd:   10,
+				EpisodeCount: 10,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "season pack with episode count",
+			input: "Show.S01.Complete.10.Episodes.1080p.WEB-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				IsComplete:   true,
+				EpisodeCount: 10,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			// "1.Episode" here is the season number before an episode word
+			name:  "singular episode word is not a count",
+			input: "Show.Season.1.Episode.5.720p.HDTV-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Resolution:   "720p",
+				Source:       "HDTV",
+				ReleaseGroup: "GROUP",
+				Unparsed:     "Episode 5",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "web with DL token",
+			input: "Show.S01E01.1080p.WEB.DL.H264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "web with streaming service tag",
+			input: "Show.S01E01.1080p.AMZN.WEB.H264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "web with rip token",
+			input: "Show.S01E01.1080p.WEB.Rip.H264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Show",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEBRip",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "rip in the title is no web hint",
+			input: "Rip.Tide.S01E01.1080p.WEB.H264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Rip Tide",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "service name in the title is no web hint",
+			input: "Hulu.Originals.S01E01.1080p.WEB.H264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Hulu Originals",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEB",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "multi with french scene tag",
+			input: "Movie.2019.MULTi.VFF.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				Language:     "Multi",
+				Languages:    []string{"Multi", "French"},
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "specific language repeating general one",
+			input: "Movie.2019.FRENCH.VFF.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				Language:     "French",
+				Languages:    []string{"French"},
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			// Language is the earliest of several
+			name:  "two languages",
+			input: "Movie.2019.GERMAN.ENGLISH.1080p.BluRay.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				Language:     "German",
+				Languages:    []string{"German", "English"},
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			// A repeated language is recorded once and does not end the scan
+			name:  "repeated language",
+			input: "Movie.2019.PROPER.ENGLISH.1080p.BluRay.ENGLISH.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2019,
+				IsProper:     true,
+				Language:     "English",
+				Languages:    []string{"English"},
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "leading metadata before title",
+			input: "1080p.WEB-DL.The.Office.US.S01E01-GROUP",
+			expected: &TorrentInfo{
+				Title:        "The Office",
+				Country:      "US",
+				Season:       1,
+				Episode:      1,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "leading codec and source before title",
+			input: "x264.BluRay.Movie.2010-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Movie",
+				Year:         2010,
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "title starting with a source word",
+			input: "Cam.Girl.2020.1080p.WEB-DL-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Cam Girl",
+				Year:         2020,
+				Resolution:   "1080p",
+				Source:       "WEB-DL",
+				ReleaseGroup: "GROUP",
+				Ignored:      []string{"Cam"},
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight,
+			},
+		},
+		{
+			name:  "audio track languages",
+			input: "Movie.2019.1080p.BluRay.TrueHD.ENG-FRE-GROUP",
+			expected: &TorrentInfo{
+				Title:          "Movie",
+				Year:           2019,
+				Resolution:     "1080p",
+				Source:         "BluRay",
+				Audio:          "TrueHD",
+				AudioLanguages: []string{"English", "French"},
+				ReleaseGroup:   "GROUP",
+				Confidence:     YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "audio track languages joined by plus",
+			input: "Movie.2019.1080p.BluRay.DTS.ENG+FRE.x264-GROUP",
+			expected: &TorrentInfo{
+				Title:          "Movie",
+				Year:           2019,
+				Resolution:     "1080p",
+				Source:         "BluRay",
+				Audio:          "DTS",
+				AudioLanguages: []string{"English", "French"},
+				Codec:          "H264",
+				ReleaseGroup:   "GROUP",
+				Confidence:     YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "french audio tag",
+			input: "Movie.2019.1080p.BluRay.AC3.VFF-GROUP",
+			expected: &TorrentInfo{
+				Title:          "Movie",
+				Year:           2019,
+				Resolution:     "1080p",
+				Source:         "BluRay",
+				Audio:          "AC3",
+				Language:       "French",
+				Languages:      []string{"French"},
+				AudioLanguages: []string{"French"},
+				ReleaseGroup:   "GROUP",
+				Confidence:     YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight + MinorFieldWeight,
+			},
+		},
+		{
+			name:  "duplicate definitely metadata",
+			input: "Some.Movie.2020.1080p.720p.BluRay.WEB.x264.H265-GROUP",
+			expected: &TorrentInfo{
+				Title:        "Some Movie 2020 1080p 720p BluRay WEB x264",
+				Codec:        "H265", // First codec found (back-to-front scan)
+				ReleaseGroup: "GROUP",
+				Ignored:      []string{"1080p", "BluRay", "x264"},
+				Confidence:   ReleaseGroupWeight + MinorFieldWeight,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Parse(tt.input)
+
+			// Full struct comparison
+			compareTorrentInfo(t, result, tt.expected)
+		})
+	}
+}
+
+// compareTorrentInfo checks all fields for equality, including omitempty and slices
+func compareTorrentInfo(t *testing.T, got, want *TorrentInfo) {
+	if got == nil && want == nil {
+		return
+	}
+	if got == nil || want == nil {
+		t.Errorf("TorrentInfo: got %v, want %v", got, want)
+		return
+	}
+	if got.Title != want.Title {
+		t.Errorf("Title: got %q, want %q", got.Title, want.Title)
+	}
+	if got.Year != want.Year {
+		t.Errorf("Year: got %d, want %d", got.Year, want.Year)
+	}
+	if got.Date != want.Date {
+		t.Errorf("Date: got %q, want %q", got.Date, want.Date)
+	}
+	if got.Season != want.Season {
+		t.Errorf("Season: got %d, want %d", got.Season, want.Season)
+	}
+	if got.SeasonEnd != want.SeasonEnd {
+		t.Errorf("SeasonEnd: got %d, want %d", got.SeasonEnd, want.SeasonEnd)
+	}
+	if got.Episode != want.Episode {
+		t.Errorf("Episode: got %d, want %d", got.Episode, want.Episode)
+	}
+	if got.EpisodeEnd != want.EpisodeEnd {
+		t.Errorf("EpisodeEnd: got %d, want %d", got.EpisodeEnd, want.EpisodeEnd)
+	}
+	if got.EpisodeCount != want.EpisodeCount {
+		t.Errorf("EpisodeCount: got %d, want %d", got.EpisodeCount, want.EpisodeCount)
+	}
+	if got.Resolution != want.Resolution {
+		t.Errorf("Resolution: got %q, want %q", got.Resolution, want.Resolution)
+	}
+	if got.Source != want.Source {
+		t.Errorf("Source: got %q, want %q", got.Source, want.Source)
+	}
+	if !reflect.DeepEqual(got.Ignored, want.Ignored) {
+		t.Errorf("Ignored: got %q, want %q", got.Ignored, want.Ignored)
+	}
+	if got.Is3D != want.Is3D {
+		t.Errorf("Is3D: got %v, want %v", got.Is3D, want.Is3D)
+	}
+	if got.ThreeDLayout != want.ThreeDLayout {
+		t.Errorf("ThreeDLayout: got %q, want %q", got.ThreeDLayout, want.ThreeDLayout)
+	}
+	if got.AudioBitrate != want.AudioBitrate {
+		t.Errorf("AudioBitrate: got %q, want %q", got.AudioBitrate, want.AudioBitrate)
+	}
+	if got.AudioBitDepth != want.AudioBitDepth {
+		t.Errorf("AudioBitDepth: got %q, want %q", got.AudioBitDepth, want.AudioBitDepth)
+	}
+	if got.AudioSampleRate != want.AudioSampleRate {
+		t.Errorf("AudioSampleRate: got %q, want %q", got.AudioSampleRate, want.AudioSampleRate)
+	}
+	if got.DiscType != want.DiscType {
+		t.Errorf("DiscType: got %q, want %q", got.DiscType, want.DiscType)
+	}
+	if got.Codec != want.Codec {
+		t.Errorf("Codec: got %q, want %q", got.Codec, want.Codec)
+	}
+	if got.Audio != want.Audio {
+		t.Errorf("Audio: got %q, want %q", got.Audio, want.Audio)
+	}
+	if got.ReleaseGroup != want.ReleaseGroup {
+		t.Errorf("ReleaseGroup: got %q, want %q", got.ReleaseGroup, want.ReleaseGroup)
+	}
+	if got.IndexerTag != want.IndexerTag {
+		t.Errorf("IndexerTag: got %q, want %q", got.IndexerTag, want.IndexerTag)
+	}
+	if got.Container != want.Container {
+		t.Errorf("Container: got %q, want %q", got.Container, want.Container)
+	}
+	if got.IsSubtitle != want.IsSubtitle {
+		t.Errorf("IsSubtitle: got %v, want %v", got.IsSubtitle, want.IsSubtitle)
+	}
+	if got.FileSize != want.FileSize {
+		t.Errorf("FileSize: got %q, want %q", got.FileSize, want.FileSize)
+	}
+	if got.Language != want.Language {
+		t.Errorf("Language: got %q, want %q", got.Language, want.Language)
+	}
+	if !reflect.DeepEqual(got.Languages, want.Languages) {
+		t.Errorf("Languages: got %v, want %v", got.Languages, want.Languages)
+	}
+	if !reflect.DeepEqual(got.AudioLanguages, want.AudioLanguages) {
+		t.Errorf("AudioLanguages: got %v, want %v", got.AudioLanguages, want.AudioLanguages)
+	}
+	if !reflect.DeepEqual(got.SceneTags, want.SceneTags) {
+		t.Errorf("SceneTags: got %q, want %q", got.SceneTags, want.SceneTags)
+	}
+	if !reflect.DeepEqual(got.Subtitles, want.Subtitles) {
+		t.Errorf("Subtitles: got %v, want %v", got.Subtitles, want.Subtitles)
+	}
+	if got.IsComplete != want.IsComplete {
+		t.Errorf("IsComplete: got %v, want %v", got.IsComplete, want.IsComplete)
+	}
+	if got.IsCompleteSeries != want.IsCompleteSeries {
+		t.Errorf("IsCompleteSeries: got %v, want %v", got.IsCompleteSeries, want.IsCompleteSeries)
+	}
+	if got.Country != want.Country {
+		t.Errorf("Country: got %q, want %q", got.Country, want.Country)
+	}
+	if got.Volume != want.Volume {
+		t.Errorf("Volume: got %d, want %d", got.Volume, want.Volume)
+	}
+	if got.Part != want.Part {
+		t.Errorf("Part: got %d, want %d", got.Part, want.Part)
+	}
+	if got.ListIndex != want.ListIndex {
+		t.Errorf("ListIndex: got %d, want %d", got.ListIndex, want.ListIndex)
+	}
+	if got.HDR != want.HDR {
+		t.Errorf("HDR: got %q, want %q", got.HDR, want.HDR)
+	}
+	if got.FrameRate != want.FrameRate {
+		t.Errorf("FrameRate: got %d, want %d", got.FrameRate, want.FrameRate)
+	}
+	if got.IsMiniseries != want.IsMiniseries {
+		t.Errorf("IsMiniseries: got %v, want %v", got.IsMiniseries, want.IsMiniseries)
+	}
+	if got.IsProper != want.IsProper {
+		t.Errorf("IsProper: got %v, want %v", got.IsProper, want.IsProper)
+	}
+	if got.IsRepack != want.IsRepack {
+		t.Errorf("IsRepack: got %v, want %v", got.IsRepack, want.IsRepack)
+	}
+	if got.IsHardcoded != want.IsHardcoded {
+		t.Errorf("IsHardcoded: got %v, want %v", got.IsHardcoded, want.IsHardcoded)
+	}
+	if got.IsDubbed != want.IsDubbed {
+		t.Errorf("IsDubbed: got %v, want %v", got.IsDubbed, want.IsDubbed)
+	}
+	if got.YearEnd != want.YearEnd {
+		t.Errorf("YearEnd: got %d, want %d", got.YearEnd, want.YearEnd)
+	}
+	if got.RemasterYear != want.RemasterYear {
+		t.Errorf("RemasterYear: got %d, want %d", got.RemasterYear, want.RemasterYear)
+	}
+	if got.Edition != want.Edition {
+		t.Errorf("Edition: got %q, want %q", got.Edition, want.Edition)
+	}
+	if got.IsExtra != want.IsExtra {
+		t.Errorf("IsExtra: got %v, want %v", got.IsExtra, want.IsExtra)
+	}
+	if got.ExtraType != want.ExtraType {
+		t.Errorf("ExtraType: got %q, want %q", got.ExtraType, want.ExtraType)
+	}
+	if got.Confidence != want.Confidence {
+		t.Errorf("Confidence: got %d, want %d", got.Confidence, want.Confidence)
+	}
+	if got.EpisodeTitle != want.EpisodeTitle {
+		t.Errorf("EpisodeTitle: got %q, want %q", got.EpisodeTitle, want.EpisodeTitle)
+	}
+	if got.Unparsed != want.Unparsed {
+		t.Errorf("Unparsed: got %q, want %q", got.Unparsed, want.Unparsed)
+	}
+}
+
+func TestParseEmptyInput(t *testing.T) {
+	inputs := []string{"", " ", "\t\n", ".", "----", "_ . -"}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			result := Parse(input)
+			if result == nil {
+				t.Fatalf("Parse(%q) returned nil", input)
+			}
+			compareTorrentInfo(t, result, &TorrentInfo{})
+		})
+	}
+
+	// Two blank names have the same (empty) title
+	if !MatchTitles(Parse("").Title, Parse("_ . -").Title, TitleMatchThreshold) {
+		t.Error("MatchTitles: titles of two blank names should match")
+	}
+	if MatchTitles(Parse("").Title, Parse("The.Matrix.1999").Title, TitleMatchThreshold) {
+		t.Error("MatchTitles: a blank name's title should not match a real title")
+	}
+}
+
+func TestParseZeroMetadataConfidence(t *testing.T) {
+	for _, input := range []string{"Some Movie", "Just.A.Title", "x"} {
+		if got := Parse(input).Confidence; got != 0 {
+			t.Errorf("Parse(%q).Confidence = %d, want 0", input, got)
+		}
+	}
+}
+
+func TestSeasonEpisodeVariants(t *testing.T) {
+	tests := []struct {
+		input   string
+		season  int
+		episode int
+	}{
+		{"Show.S01E01.720p.HDTV-GROUP", 1, 1},
+		{"Show.s01e01.720p.HDTV-GROUP", 1, 1},
+		{"Show.S1E1.720p.HDTV-GROUP", 1, 1},
+		{"Show.S1.E1.720p.HDTV-GROUP", 1, 1},
+		{"Show.s1.e1.720p.HDTV-GROUP", 1, 1},
+		{"Show S02 E10 720p HDTV-GROUP", 2, 10},
+		{"Show.S01-E05.1080p.WEB-GROUP", 1, 5},
+		{"Show.S01.E05.1080p.WEB-GROUP", 1, 5},
+		{"Show S01 E05 1080p WEB-GROUP", 1, 5},
+		{"Show_S01_E05_1080p_WEB-GROUP", 1, 5},
+		{"Show.01x01.720p.HDTV-GROUP", 1, 1},
+		{"Show.1x1.720p.HDTV-GROUP", 1, 1},
+		{"Show.3X12.720p.HDTV-GROUP", 3, 12},
+		{"Show.12x05.720p.HDTV-GROUP", 12, 5},
+		{"Show.1x100.720p.HDTV-GROUP", 1, 100},
+		{"Show.12x100.720p.HDTV-GROUP", 12, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Title != "Show" {
+				t.Errorf("Title: got %q, want %q", result.Title, "Show")
+			}
+			if result.Season != tt.season {
+				t.Errorf("Season: got %d, want %d", result.Season, tt.season)
+			}
+			if result.Episode != tt.episode {
+				t.Errorf("Episode: got %d, want %d", result.Episode, tt.episode)
+			}
+		})
+	}
+}
+
+func TestDimensionsAreNotEpisodes(t *testing.T) {
+	tests := []struct {
+		input      string
+		resolution string
+	}{
+		{"Movie.2020.1920x1080.x264-GROUP", "1080p"},
+		{"Movie.1280x720.BluRay.x264-GROUP", "720p"},
+		{"Movie.3840x2160.BluRay.x265-GROUP", "2160p"},
+		{"Movie.1920x800.BluRay.x264-GROUP", "1080p"},
+		{"Movie.1440x1080.HDTV.x264-GROUP", "1080p"},
+		{"Movie.640x480.DVD-GROUP", "480p"},
+		{"Movie.2020.3840x2160.HDR.x265-GROUP", "2160p"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.HasSeason || result.Season != 0 || result.Episode != 0 {
+				t.Errorf("got season %d episode %d (HasSeason %v), want none", result.Season, result.Episode, result.HasSeason)
+			}
+			if result.Resolution != tt.resolution {
+				t.Errorf("Resolution: got %q, want %q", result.Resolution, tt.resolution)
+			}
+			if result.Title != "Movie" {
+				t.Errorf("Title: got %q, want %q", result.Title, "Movie")
+			}
+		})
+	}
+}
+
+func TestDimensionsResolution(t *testing.T) {
+	tests := []struct {
+		dimensions string
+		expected   string
+	}{
+		{"7680x4320", "4320p"},
+		{"3840x2160", "2160p"},
+		{"4096x2160", "2160p"},
+		{"3840x1600", "2160p"},
+		{"2560x1440", "1440p"},
+		{"1920x1080", "1080p"},
+		{"1920x800", "1080p"},
+		{"1440x1080", "1080p"},
+		{"1280x720", "720p"},
+		{"960x720", "720p"},
+		{"720x576", "480p"},
+		{"640x480", "480p"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dimensions, func(t *testing.T) {
+			if got := dimensionsResolution(tt.dimensions); got != tt.expected {
+				t.Errorf("dimensionsResolution(%q) = %q, want %q", tt.dimensions, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResolutionTokenBeatsDimensions(t *testing.T) {
+	result := Parse("Movie.2020.720p.1920x1080.BluRay-GROUP")
+	if result.Resolution != "720p" {
+		t.Errorf("Resolution: got %q, want %q", result.Resolution, "720p")
+	}
+	if len(result.Ignored) != 0 {
+		t.Errorf("Ignored: got %q, want none", result.Ignored)
+	}
+}
+
+func TestExtractUnparsedContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"dotted episode title", "S01E01.Pilot.720p.HDTV", "Pilot"},
+		{"underscore episode title", "S01E01.Pilot_Part.720p.HDTV", "Pilot Part"},
+		{"underscore separators", "S01E01_Pilot_Part_720p_HDTV", "Pilot Part"},
+		{"no leftovers", "1080p.BluRay.x264", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := extractUnparsedContent(tt.input, 0)
+			if result != tt.expected {
+				t.Errorf("extractUnparsedContent(%q, 0) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExtendedEditions(t *testing.T) {
+	tests := []struct {
+		input   string
+		edition string
+	}{
+		{"Movie.2001.EXTENDED.1080p.BluRay-GROUP", "Extended"},
+		{"Movie.2001.EXTENDED.CUT.1080p.BluRay-GROUP", "Extended Cut"},
+		{"Movie.2001.Extended.Edition.1080p.BluRay-GROUP", "Extended Edition"},
+		{"Movie.2001.1080p.EXTENDED.CUT.BluRay-GROUP", "Extended Cut"},
+		{"Movie.2001.1080p.Extended.Edition.BluRay-GROUP", "Extended Edition"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Title != "Movie" {
+				t.Errorf("Title: got %q, want %q", result.Title, "Movie")
+			}
+			if result.Year != 2001 {
+				t.Errorf("Year: got %d, want %d", result.Year, 2001)
+			}
+			if result.Edition != tt.edition {
+				t.Errorf("Edition: got %q, want %q", result.Edition, tt.edition)
+			}
+			if result.Unparsed != "" {
+				t.Errorf("Unparsed: got %q, want empty", result.Unparsed)
+			}
+		})
+	}
+}
+
+func TestDirectorsCut(t *testing.T) {
+	tests := []struct {
+		input   string
+		title   string
+		year    int
+		edition string
+	}{
+		{"Blade.Runner.1982.Directors.Cut.1080p.BluRay-GROUP", "Blade Runner", 1982, "Directors Cut"},
+		{"Blade Runner 1982 Director's Cut 1080p BluRay-GROUP", "Blade Runner", 1982, "Directors Cut"},
+		{"Blade.Runner.1982.DIRECTORS.CUT.1080p.BluRay-GROUP", "Blade Runner", 1982, "Directors Cut"},
+		{"Blade.Runner.1982.DC.1080p.BluRay-GROUP", "Blade Runner", 1982, "Directors Cut"},
+		{"Blade.Runner.1982.1080p.DC.BluRay-GROUP", "Blade Runner", 1982, "Directors Cut"},
+		// DC in the title is left alone
+		{"DC.Super.Hero.Girls.2019.1080p.WEB-DL-GROUP", "DC Super Hero Girls", 2019, ""},
+		{"Legends.of.DC.1080p.WEB-DL-GROUP", "Legends of DC", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Title != tt.title {
+				t.Errorf("Title: got %q, want %q", result.Title, tt.title)
+			}
+			if result.Year != tt.year {
+				t.Errorf("Year: got %d, want %d", result.Year, tt.year)
+			}
+			if result.Edition != tt.edition {
+				t.Errorf("Edition: got %q, want %q", result.Edition, tt.edition)
+			}
+			if result.Unparsed != "" {
+				t.Errorf("Unparsed: got %q, want empty", result.Unparsed)
+			}
+		})
+	}
+}
+
+func TestIgnoredDuplicates(t *testing.T) {
+	tests := []struct {
+		input   string
+		ignored []string
+	}{
+		{"Some.Movie.2020.1080p.720p.BluRay.WEB.x264.H265-GROUP", []string{"1080p", "BluRay", "x264"}},
+		{"Movie.2020.1080p.720p.BluRay-GROUP", []string{"1080p"}},
+		{"Movie.2019.1080p.BluRay.x264.EXTENDED.UNRATED-GROUP", []string{"EXTENDED"}},
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if !reflect.DeepEqual(result.Ignored, tt.ignored) {
+				t.Errorf("Ignored: got %q, want %q", result.Ignored, tt.ignored)
+			}
+		})
+	}
+}
+
+func TestTitleCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"EXTENDED", "Extended"},
+		{"korean", "Korean"},
+		{"directors cut", "Directors Cut"},
+		// strings.Title would give "Director'S Cut"
+		{"director's cut", "Director's Cut"},
+		{"ÉDITION SPÉCIALE", "Édition Spéciale"},
+	}
+
+	for _, tt := range tests {
+		if result := titleCase(tt.input); result != tt.expected {
+			t.Errorf("titleCase(%q) = %q, want %q", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestEpisodeType(t *testing.T) {
+	tests := []struct {
+		input           string
+		title           string
+		episodeType     string
+		absoluteEpisode int
+	}{
+		{"[Group] Show OVA 02 [1080p]", "Show", "OVA", 2},
+		{"[Group] Show OVA [1080p]", "Show", "OVA", 0},
+		{"[Group] Show ONA 3 [1080p]", "Show", "ONA", 3},
+		{"[Group] Show SP1 [720p]", "Show", "Special", 1},
+		{"[Group] Show Special 02 [720p]", "Show", "Special", 2},
+		{"[Group] Show Movie 2 [1080p]", "Show", "Movie", 2},
+		{"Show.S01E02.720p.HDTV-GROUP", "Show", "Episode", 0},
+		{"Movie.2019.1080p.BluRay-GROUP", "Movie", "", 0},
+		{"Special.Forces.2011.1080p.BluRay-GROUP", "Special Forces", "", 0},
+		{"Scary.Movie.2.2001.1080p.BluRay.x264-GROUP", "Scary Movie 2", "", 0},
+		{"The.Lego.Movie.2.The.Second.Part.2019.1080p.BluRay.x264-GROUP", "The Lego Movie 2 The Second Part", "", 0},
+		{"Movie.43.2013.1080p.BluRay.x264-GROUP", "Movie 43", "", 0},
+		{"Show.S02.Special.1.1080p.WEB-GROUP", "Show", "Special", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Title != tt.title {
+				t.Errorf("Title: got %q, want %q", result.Title, tt.title)
+			}
+			if result.EpisodeType != tt.episodeType {
+				t.Errorf("EpisodeType: got %q, want %q", result.EpisodeType, tt.episodeType)
+			}
+			if result.AbsoluteEpisode != tt.absoluteEpisode {
+				t.Errorf("AbsoluteEpisode: got %d, want %d", result.AbsoluteEpisode, tt.absoluteEpisode)
+			}
+			if result.Unparsed != "" {
+				t.Errorf("Unparsed: got %q, want empty", result.Unparsed)
+			}
+		})
+	}
+}
+
+func TestIsSeasonPack(t *testing.T) {
+	tests := []struct {
+		input      string
+		seasonPack bool
+		complete   bool
+	}{
+		{"Game.of.Thrones.S08.1080p.BluRay.x264-ROVERS", true, false},
+		{"Breaking.Bad.S01-S05.COMPLETE.1080p.BluRay.x264-GROUP", true, true},
+		{"Breaking.Bad.S01E01.720p.HDTV.x264-CTU", false, false},
+		{"The.Daily.Show.2023.01.15.720p.WEB.x264-GROUP", false, false},
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.IsSeasonPack != tt.seasonPack {
+				t.Errorf("IsSeasonPack: got %v, want %v", result.IsSeasonPack, tt.seasonPack)
+			}
+			if result.IsComplete != tt.complete {
+				t.Errorf("IsComplete: got %v, want %v", result.IsComplete, tt.complete)
+			}
+		})
+	}
+}
+
+func TestFieldConfidence(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected map[string]float64
+	}{
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", map[string]float64{"Title": sureField, "Year": sureField}},
+		{"2012.2009.1080p.BluRay.x264-GROUP", map[string]float64{"Title": uncertainField, "Year": uncertainField}},
+		{"Some.Movie.2020.1080p.BluRay.x264.Extra.Words-GROUP", map[string]float64{"Title": likelyField, "Year": sureField}},
+		{"Blade.Runner.2049.2017.1080p.BluRay-GROUP", map[string]float64{"Title": likelyField, "Year": uncertainField}},
+		{"Some.Movie.2019.2020.1080p.BluRay-GROUP", map[string]float64{"Title": likelyField, "Year": uncertainField}},
+		{"Collection.2018.2019.2020.1080p.BluRay-GROUP", map[string]float64{"Title": likelyField, "Year": likelyField}},
+		{"Breaking.Bad.S01E01.720p.HDTV.x264-CTU", map[string]float64{"Title": sureField, "Season": sureField, "Episode": sureField}},
+		{"House.1x01.720p.HDTV.x264", map[string]float64{"Title": sureField, "Season": likelyField, "Episode": likelyField}},
+		{"Doctor.Who.Specials.1080p.BluRay-GROUP", map[string]float64{"Title": sureField, "Season": likelyField}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := Parse(tt.input).FieldConfidence
+			if len(got) != len(tt.expected) {
+				t.Fatalf("FieldConfidence: got %v, want %v", got, tt.expected)
+			}
+			for field, want := range tt.expected {
+				if got[field] != want {
+					t.Errorf("FieldConfidence[%q]: got %v, want %v", field, got[field], want)
+				}
+			}
+		})
+	}
+
+	numeric := NewParser(WithNumericEpisodeCodes(true)).Parse("Friends.101.720p.HDTV-GROUP").FieldConfidence
+	if numeric["Season"] != uncertainField || numeric["Episode"] != uncertainField {
+		t.Errorf("numeric episode code: got %v, want Season and Episode %v", numeric, uncertainField)
+	}
+}
+
+func TestKind(t *testing.T) {
+	tests := []struct {
+		input        string
+		kind         string
+		episodeTitle string
+		unparsed     string
+	}{
+		{"Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS", KindEpisode, "Pilot", ""},
+		{"The.Daily.Show.2023.01.15.720p.WEB.x264-GROUP", KindEpisode, "", ""},
+		{"Game.of.Thrones.S08.1080p.BluRay.x264-ROVERS", KindSeason, "", ""},
+		{"Breaking.Bad.S01-S05.COMPLETE.1080p.BluRay.x264-GROUP", KindSeason, "", ""},
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", KindMovie, "", ""},
+		{"Movie.2019.1080p.BluRay.x264.Extra.Words-GROUP", KindMovie, "", "Extra Words"},
+		{"Show.S01.E01-E10.Complete.1080p.WEB-GROUP", KindEpisode, "", ""},
+		{"Some Movie", KindMovie, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Kind != tt.kind {
+				t.Errorf("Kind: got %q, want %q", result.Kind, tt.kind)
+			}
+			if result.EpisodeTitle != tt.episodeTitle {
+				t.Errorf("EpisodeTitle: got %q, want %q", result.EpisodeTitle, tt.episodeTitle)
+			}
+			if result.Unparsed != tt.unparsed {
+				t.Errorf("Unparsed: got %q, want %q", result.Unparsed, tt.unparsed)
+			}
+		})
+	}
+}
+
+func TestUnicodeNames(t *testing.T) {
+	tests := []struct {
+		input        string
+		title        string
+		episodeTitle string
+		unparsed     string
+	}{
+		{"千と千尋の神隠し.2001.1080p.BluRay.x264-GROUP", "千と千尋の神隠し", "", ""},
+		{"Amélie.2001.1080p.BluRay.x264-GROUP", "Amélie", "", ""},
+		{"🎬.Movie.2020.1080p.WEB-DL-GROUP", "🎬 Movie", "", ""},
+		{"Show.S01E01.東京の夜.1080p.WEB-GROUP", "Show", "東京の夜", ""},
+		{"进击的巨人.S01E02.1080p.WEB-DL-GROUP", "进击的巨人", "", ""},
+		{"Movie.2020.1080p.BluRay.x264.字幕.Extra-GROUP", "Movie", "", "字幕 Extra"},
+		{"Ñandú.🎬.2020.720p", "Ñandú 🎬", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Title != tt.title {
+				t.Errorf("Title: got %q, want %q", result.Title, tt.title)
+			}
+			if result.EpisodeTitle != tt.episodeTitle {
+				t.Errorf("EpisodeTitle: got %q, want %q", result.EpisodeTitle, tt.episodeTitle)
+			}
+			if result.Unparsed != tt.unparsed {
+				t.Errorf("Unparsed: got %q, want %q", result.Unparsed, tt.unparsed)
+			}
+			if boundary := MetadataBoundary(tt.input); boundary < len(tt.input) && !utf8.RuneStart(tt.input[boundary]) {
+				t.Errorf("MetadataBoundary %d splits a rune", boundary)
+			}
+		})
+	}
+}
+
+func TestDubbedAnime(t *testing.T) {
+	// Absolute numbering (Show - 01) isn't read, so these use SxxEyy
+	tests := []struct {
+		input     string
+		dubbed    bool
+		subtitles []string
+	}{
+		{"[Group] Show S01E01 DUBBED 1080p-GRP", true, nil},
+		{"[Group] Show S01E01 SUBBED 1080p-GRP", false, []string{"Unknown"}},
+		{"[Group] Show S01E01 1080p-GRP", false, nil},
+		{"[Group] Show OVA 2 DUBBED [1080p]", true, nil},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
+		t.Run(tt.input, func(t *testing.T) {
 			result := Parse(tt.input)
-
-			// Full struct comparison
-			compareTorrentInfo(t, result, tt.expected)
+			if result.Title != "Show" || result.Kind != KindEpisode {
+				t.Errorf("Title/Kind: got %q/%q, want %q/%q", result.Title, result.Kind, "Show", KindEpisode)
+			}
+			if result.IsDubbed != tt.dubbed {
+				t.Errorf("IsDubbed: got %v, want %v", result.IsDubbed, tt.dubbed)
+			}
+			if !reflect.DeepEqual(result.Subtitles, tt.subtitles) {
+				t.Errorf("Subtitles: got %v, want %v", result.Subtitles, tt.subtitles)
+			}
 		})
 	}
 }
 
-// compareTorrentInfo checks all fields for equality, including omitempty and slices
-func compareTorrentInfo(t *testing.T, got, want *TorrentInfo) {
-	if got == nil && want == nil {
-		return
-	}
-	if got == nil || want == nil {
-		t.Errorf("TorrentInfo: got %v, want %v", got, want)
-		return
+func TestLooksLikeTV(t *testing.T) {
+	tests := []struct {
+		input string
+		tv    bool
+	}{
+		{"Breaking.Bad.S01E01.720p.HDTV.x264-CTU", true},
+		{"Game.of.Thrones.S08.1080p.BluRay.x264-ROVERS", true},
+		{"The.Office.Season.2.720p.WEB-DL-GROUP", true},
+		{"House.1x01.720p.HDTV.x264", true},
+		{"The.Daily.Show.2023.01.15.720p.WEB.x264-GROUP", true},
+		{"Mr.Robot.S01.Complete.1080p.BluRay-GROUP", true},
+		{"Friends.Complete.Series.1080p.BluRay-GROUP", true},
+		{"The.Sopranos.Complete.1080p.BluRay.x264-GROUP", true},
+		{"Chernobyl.COMPLETE.Miniseries.1080p.WEB-GROUP", true},
+		{"Movie.2010.Complete.1080p.BluRay-GROUP", false},
+		{"A.Complete.Unknown.1080p", false},
+		{"The.Complete.Works.of.Shakespeare.1080p", false},
+		{"Doctor.Who.Specials.1080p.BluRay-GROUP", true},
+		{"S.W.A.T.2017.S01E01.720p.HDTV.x264-GROUP", true},
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", false},
+		{"Blade.Runner.2049.2017.2160p.BluRay.HEVC-COASTER", false},
+		{"Alien.1979.Directors.Cut.1080p.BluRay-GROUP", false},
+		{"Seasons.2019.1080p.BluRay-GROUP", false},
+		{"The.Specials.2019.1080p.BluRay-GROUP", false},
+		{"Big.Specials.Night.1080p.BluRay-GROUP", false},
+		{"[Group] Show OVA 2 [1080p]", true},
+		{"Show.OVA.2.1080p.BluRay-GROUP", true},
+		{"[Group] Show SP1 [1080p]", true},
+		{"[Group] Show OVA [1080p]", false},
+		{"Scary.Movie.2.2001.1080p.BluRay-GROUP", false},
+		{"Some Movie", false},
 	}
-	if got.Title != want.Title {
-		t.Errorf("Title: got %q, want %q", got.Title, want.Title)
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := LooksLikeTV(tt.input); got != tt.tv {
+				t.Errorf("LooksLikeTV: got %v, want %v", got, tt.tv)
+			}
+			if kind := Parse(tt.input).Kind; (kind != KindMovie) != tt.tv {
+				t.Errorf("LooksLikeTV disagrees with Kind %q", kind)
+			}
+		})
 	}
-	if got.Year != want.Year {
-		t.Errorf("Year: got %d, want %d", got.Year, want.Year)
+}
+
+func TestLooksLikeTVExceptions(t *testing.T) {
+	// A numbered special among the metadata of a name that isn't fansub-style
+	input := "Show.1080p.BluRay.Special.2-GROUP"
+	if LooksLikeTV(input) || Parse(input).Kind != KindEpisode {
+		t.Errorf("%s: got LooksLikeTV %v and Kind %q, want false and episode", input, LooksLikeTV(input), Parse(input).Kind)
 	}
-	if got.Date != want.Date {
-		t.Errorf("Date: got %q, want %q", got.Date, want.Date)
+
+	// A numeric episode code, read only with WithNumericEpisodeCodes
+	input = "Friends.101.720p.HDTV-GROUP"
+	if kind := NewParser(WithNumericEpisodeCodes(true)).Parse(input).Kind; LooksLikeTV(input) || kind != KindEpisode {
+		t.Errorf("%s: got LooksLikeTV %v and Kind %q, want false and episode", input, LooksLikeTV(input), kind)
 	}
-	if got.Season != want.Season {
-		t.Errorf("Season: got %d, want %d", got.Season, want.Season)
+}
+
+func TestHasSeason(t *testing.T) {
+	tests := []struct {
+		input     string
+		hasSeason bool
+		season    int
+		episode   int
+	}{
+		{"Show.S00E01.Behind.the.Scenes.1080p.WEB-GROUP", true, 0, 1},
+		{"Show.S00.1080p.WEB-GROUP", true, 0, 0},
+		{"Show.Season.0.1080p.WEB-GROUP", true, 0, 0},
+		{"Show.Specials.1080p.WEB-GROUP", true, 0, 0},
+		{"The.Specials.Show.2019.1080p.WEB-GROUP", false, 0, 0},
+		{"The.Specials.2019.1080p.BluRay-GROUP", false, 0, 0},
+		{"The.Specials.1080p.BluRay-GROUP", false, 0, 0},
+		{"Big.Specials.Night.1080p.BluRay-GROUP", false, 0, 0},
+		{"Show.S02E03.1080p.WEB-GROUP", true, 2, 3},
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", false, 0, 0},
 	}
-	if got.Episode != want.Episode {
-		t.Errorf("Episode: got %d, want %d", got.Episode, want.Episode)
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.HasSeason != tt.hasSeason {
+				t.Errorf("HasSeason: got %v, want %v", result.HasSeason, tt.hasSeason)
+			}
+			if result.Season != tt.season || result.Episode != tt.episode {
+				t.Errorf("Season/Episode: got %d/%d, want %d/%d", result.Season, result.Episode, tt.season, tt.episode)
+			}
+		})
 	}
-	if got.Resolution != want.Resolution {
-		t.Errorf("Resolution: got %q, want %q", got.Resolution, want.Resolution)
+
+	// Specials count toward confidence like any other season
+	if got, want := Parse("Show.S00E01.1080p.WEB-GROUP").Confidence, Parse("Show.S01E01.1080p.WEB-GROUP").Confidence; got != want {
+		t.Errorf("Confidence: got %d for S00E01, want %d as for S01E01", got, want)
 	}
-	if got.Source != want.Source {
-		t.Errorf("Source: got %q, want %q", got.Source, want.Source)
+}
+
+func TestAudioTokenForms(t *testing.T) {
+	tests := []struct {
+		input string
+		audio string
+	}{
+		{"Movie.2019.1080p.WEB-DL.DDP5.1.H264-GROUP", "DDP 5.1"},
+		{"Movie.2019.1080p.WEB-DL.DD5.1.H264-GROUP", "DD 5.1"},
+		{"Movie.2019.1080p.WEB-DL.DD+5.1.H264-GROUP", "DD+ 5.1"},
+		{"Movie.2019.1080p.BluRay.AC3.5.1.x264-GROUP", "AC3 5.1"},
+		{"Movie.2019.1080p.BluRay.AC3 5.1.x264-GROUP", "AC3 5.1"},
+		{"Movie.2019.1080p.WEB-DL.AAC2.0.H264-GROUP", "AAC 2.0"},
+		{"Movie.2019.1080p.WEB-DL.E-AC-3.5.1.H264-GROUP", "EAC3 5.1"},
+		{"Movie.2019.1080p.BluRay.AC-3.H264-GROUP", "AC3"},
 	}
-	if got.Codec != want.Codec {
-		t.Errorf("Codec: got %q, want %q", got.Codec, want.Codec)
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Title != "Movie" {
+				t.Errorf("Title: got %q, want %q", result.Title, "Movie")
+			}
+			if result.Audio != tt.audio {
+				t.Errorf("Audio: got %q, want %q", result.Audio, tt.audio)
+			}
+			if result.Codec != "H264" {
+				t.Errorf("Codec: got %q, want %q", result.Codec, "H264")
+			}
+			if result.Unparsed != "" {
+				t.Errorf("Unparsed: got %q, want empty", result.Unparsed)
+			}
+		})
 	}
-	if got.Audio != want.Audio {
-		t.Errorf("Audio: got %q, want %q", got.Audio, want.Audio)
+}
+
+func TestMetadataBoundary(t *testing.T) {
+	tests := []struct {
+		input    string
+		metadata string // expected name[boundary:]
+	}{
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", "1999.1080p.BluRay.x264-SPARKS"},
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS.mkv", "1999.1080p.BluRay.x264-SPARKS.mkv"},
+		{"The.Daily.Show.2023.10.15.1080p.WEB", "2023.10.15.1080p.WEB"},
+		{"Show.2023.10.15.Guest.Name.1080p.WEB", "1080p.WEB"},
+		{"1080p.WEB-DL.The.Office.US.S01E01-GROUP", "S01E01-GROUP"},
+		{"The_Matrix_1999_1080p", "1999_1080p"},
+		{"Movie.2021.BluRay.2160p.HEVC-GROUP", "2021.BluRay.2160p.HEVC-GROUP"},
+		{"Movie.BluRay.1080p.x264-GROUP", "BluRay.1080p.x264-GROUP"},
+		{"Some Movie", ""},
+		{"", ""},
 	}
-	if got.ReleaseGroup != want.ReleaseGroup {
-		t.Errorf("ReleaseGroup: got %q, want %q", got.ReleaseGroup, want.ReleaseGroup)
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			boundary := MetadataBoundary(tt.input)
+			if got := tt.input[boundary:]; got != tt.metadata {
+				t.Errorf("MetadataBoundary(%q) = %d, metadata %q, want %q", tt.input, boundary, got, tt.metadata)
+			}
+		})
 	}
-	if got.Container != want.Container {
-		t.Errorf("Container: got %q, want %q", got.Container, want.Container)
+}
+
+func TestPaddedSeparators(t *testing.T) {
+	inputs := []string{
+		"The..Matrix...1999..1080p..BluRay..x264-GROUP",
+		"..The.Matrix.1999.1080p.BluRay.x264-GROUP",
+		"The.Matrix.1999.1080p.BluRay.x264-GROUP..",
+		". The . Matrix . 1999 . 1080p . BluRay . x264-GROUP .",
 	}
-	if got.Language != want.Language {
-		t.Errorf("Language: got %q, want %q", got.Language, want.Language)
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			compareTorrentInfo(t, Parse(input), &TorrentInfo{
+				Title:        "The Matrix",
+				Year:         1999,
+				Resolution:   "1080p",
+				Source:       "BluRay",
+				Codec:        "H264",
+				ReleaseGroup: "GROUP",
+				Confidence:   YearSeasonWeight + ResolutionWeight + SourceWeight + ReleaseGroupWeight + MinorFieldWeight,
+			})
+		})
 	}
-	if !reflect.DeepEqual(got.Subtitles, want.Subtitles) {
-		t.Errorf("Subtitles: got %v, want %v", got.Subtitles, want.Subtitles)
+}
+
+func TestEncoderCodec(t *testing.T) {
+	tests := []struct {
+		input        string
+		codec        string
+		encoderCodec string
+	}{
+		{"Movie.2020.1080p.BluRay.x265-GROUP", "H265", "x265"},
+		{"Movie.2020.1080p.BluRay.X264-GROUP", "H264", "x264"},
+		{"Movie.2020.1080p.WEB.H264-GROUP", "H264", "H264"},
+		{"Movie.2020.2160p.BluRay.HEVC-GROUP", "H265", "HEVC"},
+		{"Movie.2020.1080p.BluRay.AVC-GROUP", "H264", "AVC"},
+		{"Movie.2020.1080p.WEB.H.264-GROUP", "H264", "H264"},
+		{"Movie.2020.2160p.WEB.h.265-GROUP", "H265", "H265"},
+		{"Movie.2020.1080p.BluRay-GROUP", "", ""},
 	}
-	if got.IsComplete != want.IsComplete {
-		t.Errorf("IsComplete: got %v, want %v", got.IsComplete, want.IsComplete)
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Codec != tt.codec {
+				t.Errorf("Codec: got %q, want %q", result.Codec, tt.codec)
+			}
+			if result.EncoderCodec != tt.encoderCodec {
+				t.Errorf("EncoderCodec: got %q, want %q", result.EncoderCodec, tt.encoderCodec)
+			}
+		})
 	}
-	if got.IsProper != want.IsProper {
-		t.Errorf("IsProper: got %v, want %v", got.IsProper, want.IsProper)
+}
+
+func TestRawTokens(t *testing.T) {
+	tests := []struct {
+		input     string
+		source    string
+		sourceRaw string
+		codec     string
+		codecRaw  string
+	}{
+		{"Movie.2020.1080p.BLU-RAY.x265-GROUP", "BluRay", "BLU-RAY", "H265", "x265"},
+		{"Movie.2020.1080p.WEBDL.h264-GROUP", "WEB-DL", "WEBDL", "H264", "h264"},
+		{"Movie.2020.2160p.BluRay.REMUX.HEVC-GROUP", "REMUX", "REMUX", "H265", "HEVC"},
+		{"Movie.2020.2160p.BDRemux.BluRay.HEVC-GROUP", "REMUX", "BDRemux", "H265", "HEVC"},
+		{"Movie.2020.1080p.WEB.H.264-GROUP", "WEB", "WEB", "H264", "H.264"},
+		{"Movie.2019.WEB-DLRip.1080p.x264-GROUP", "WEBRip", "WEB-DLRip", "H264", "x264"},
+		{"Movie.2019.WEBDL.Rip.x264-GROUP", "WEBRip", "WEBDL.Rip", "H264", "x264"},
+		{"Movie.2019.1080p.BDMux.x264-GROUP", "BluRay", "BDMux", "H264", "x264"},
+		{"Movie.2020.1080p-GROUP", "", "", "", ""},
 	}
-	if got.IsRepack != want.IsRepack {
-		t.Errorf("IsRepack: got %v, want %v", got.IsRepack, want.IsRepack)
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Source != tt.source || result.SourceRaw != tt.sourceRaw {
+				t.Errorf("Source: got %q (raw %q), want %q (raw %q)", result.Source, result.SourceRaw, tt.source, tt.sourceRaw)
+			}
+			if result.Codec != tt.codec || result.CodecRaw != tt.codecRaw {
+				t.Errorf("Codec: got %q (raw %q), want %q (raw %q)", result.Codec, result.CodecRaw, tt.codec, tt.codecRaw)
+			}
+		})
 	}
-	if got.IsHardcoded != want.IsHardcoded {
-		t.Errorf("IsHardcoded: got %v, want %v", got.IsHardcoded, want.IsHardcoded)
+}
+
+func TestResolutionFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		is4K  bool
+		isHD  bool
+	}{
+		{"2160p", "Blade.Runner.2049.2017.2160p.BluRay.HEVC-COASTER", true, false},
+		{"4K normalized", "Some.Movie.2020.4K.WEB-DL.x265-GROUP", true, false},
+		{"4320p", "Some.Movie.2020.4320p.WEB-DL.x265-GROUP", true, false},
+		{"1440p", "Some.Movie.2020.1440p.WEB-DL.x264-GROUP", false, true},
+		{"1080p", "The.Matrix.1999.1080p.BluRay.x264-SPARKS", false, true},
+		{"720p", "Some.Movie.2000.720p.HDTV.x264.avi", false, true},
+		{"480p", "Classic.Movie.1950.480p.DVD.Mono.x264-GROUP", false, false},
+		{"no resolution", "Some Movie", false, false},
 	}
-	if got.Edition != want.Edition {
-		t.Errorf("Edition: got %q, want %q", got.Edition, want.Edition)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Parse(tt.input)
+			if result.Is4K != tt.is4K {
+				t.Errorf("Is4K: got %v, want %v (Resolution %q)", result.Is4K, tt.is4K, result.Resolution)
+			}
+			if result.IsHD != tt.isHD {
+				t.Errorf("IsHD: got %v, want %v (Resolution %q)", result.IsHD, tt.isHD, result.Resolution)
+			}
+		})
 	}
-	if got.Confidence != want.Confidence {
-		t.Errorf("Confidence: got %d, want %d", got.Confidence, want.Confidence)
+}
+
+// benchmarkNames is the corpus shared by the Parse benchmarks
+var benchmarkNames = []string{
+	"The.Matrix.1999.1080p.BluRay.x264-SPARKS",
+	"Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS",
+	"Game.of.Thrones.S08.COMPLETE.1080p.BluRay.x264-ROVERS[rartv]",
+	"The.Lord.of.the.Rings.The.Fellowship.of.the.Ring.2001.EXTENDED.1080p.BluRay.x265-RARBG",
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, name := range benchmarkNames {
+			Parse(name)
+		}
 	}
-	if got.Unparsed != want.Unparsed {
-		t.Errorf("Unparsed: got %q, want %q", got.Unparsed, want.Unparsed)
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	var info TorrentInfo
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, name := range benchmarkNames {
+			ParseInto(name, &info)
+		}
 	}
 }
 
-func BenchmarkParse(b *testing.B) {
-	torrentNames := []string{
+func TestParseInto(t *testing.T) {
+	names := []string{
 		"The.Matrix.1999.1080p.BluRay.x264-SPARKS",
 		"Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS",
-		"Game.of.Thrones.S08.COMPLETE.1080p.BluRay.x264-ROVERS[rartv]",
-		"The.Lord.of.the.Rings.The.Fellowship.of.the.Ring.2001.EXTENDED.1080p.BluRay.x265-RARBG",
+		"Movie.2019.1080p.BluRay.MULTi.VFF.TrueHD.ENG-FRE-GROUP",
+		"Movie.2009.3D.1080p.HSBS.BluRay.x264-GROUP",
+		"Some.Movie.2020.1080p.720p.BluRay.WEB.x264.H265-GROUP",
+		"1080p.WEB-DL.The.Office.S01E01-GROUP.mkv",
+		"Some Movie",
+		"",
 	}
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		for _, name := range torrentNames {
-			Parse(name)
+	// One dst reused across every name, so leftovers from a previous parse would show
+	var dst TorrentInfo
+	for _, name := range names {
+		ParseInto(name, &dst)
+		if want := Parse(name); !reflect.DeepEqual(&dst, want) {
+			t.Errorf("ParseInto(%q) = %+v, want %+v", name, dst, *want)
 		}
 	}
 }
@@ -814,6 +3576,21 @@ func TestNormalizeTitle(t *testing.T) {
 			input:    "The MATRIX and the Reloaded",
 			expected: "matrix reloaded",
 		},
+		{
+			name:     "cjk title",
+			input:    "进击的巨人",
+			expected: "进击的巨人",
+		},
+		{
+			name:     "accented title",
+			input:    "Amélie",
+			expected: "amélie",
+		},
+		{
+			name:     "no-break spaces",
+			input:    "The\u00a0Matrix\u00a0\u00a0Reloaded",
+			expected: "matrix reloaded",
+		},
 	}
 
 	for _, tt := range tests {
@@ -826,6 +3603,98 @@ func TestNormalizeTitle(t *testing.T) {
 	}
 }
 
+func TestNormalizeTitleWith(t *testing.T) {
+	italian := map[string]bool{"la": true, "il": true, "e": true}
+
+	tests := []struct {
+		name      string
+		input     string
+		stopwords map[string]bool
+		expected  string
+	}{
+		{"nil set keeps every word", "The Lord of the Rings", nil, "the lord of the rings"},
+		{"empty set keeps every word", "The Lord of the Rings", map[string]bool{}, "the lord of the rings"},
+		{"custom set", "La Vita e Bella", italian, "vita bella"},
+		{"custom set ignores english", "The Matrix", italian, "the matrix"},
+		{"english set matches NormalizeTitle", "The Matrix and the Reloaded", englishStopwords, "matrix reloaded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NormalizeTitleWith(tt.input, tt.stopwords)
+			if result != tt.expected {
+				t.Errorf("NormalizeTitleWith(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeWhitespace(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"The Matrix", "The Matrix"},
+		{"  The \t Matrix\n", "The Matrix"},
+		{"The\u00a0Matrix\u00a0\u00a0Reloaded", "The Matrix Reloaded"},
+		{"\u00a0", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if result := NormalizeWhitespace(tt.input); result != tt.expected {
+			t.Errorf("NormalizeWhitespace(%q) = %q, want %q", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestCleanTitle(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"The.Matrix", "The Matrix"},
+		{"The_Office_US", "The Office US"},
+		{"[Group] Show Name", "Show Name"},
+		{"Some Movie (2010)", "Some Movie"},
+		{"[Group] The.Office_US (2005)", "The Office US"},
+		{"Movie (Part 1) Title", "Movie (Part 1) Title"},
+		{"  Spaced\u00a0 Out. ", "Spaced Out"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if result := CleanTitle(tt.input); result != tt.expected {
+			t.Errorf("CleanTitle(%q) = %q, want %q", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"movie", "The.Matrix.1999.1080p.BluRay.x264-SPARKS", "the-matrix-1999"},
+		{"tv episode", "Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS", "breaking-bad-s01e01"},
+		{"season pack", "Game.of.Thrones.S08.Complete.1080p.BluRay.x264-ROVERS[rartv]", "game-of-thrones-s08"},
+		{"specials", "Show.S00E01.Behind.the.Scenes.1080p.WEB-GROUP", "show-s00e01"},
+		{"punctuation collapsed", "Marvel's.Agents.of.S.H.I.E.L.D.2013.720p", "marvel-s-agents-of-s-h-i-e-l-d-2013"},
+		{"no year", "Some.Movie.1080p.BluRay.x264-SPARKS", "some-movie"},
+		{"empty title", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Parse(tt.input).Slug()
+			if result != tt.expected {
+				t.Errorf("Slug() for %q = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestMatchTitles(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -998,3 +3867,70 @@ func TestCalculateSimilarity(t *testing.T) {
 		})
 	}
 }
+
+func TestTitleSimilarityWeighted(t *testing.T) {
+	corpus := []string{
+		"Star Wars The Force Awakens",
+		"Star Wars The Last Jedi",
+		"Star Wars A New Hope",
+		"The Matrix",
+	}
+
+	t.Run("franchise words count less", func(t *testing.T) {
+		a, b := "Star Wars The Force Awakens", "Star Wars The Last Jedi"
+		plain := calculateSimilarity(NormalizeTitle(a), NormalizeTitle(b))
+		weighted := TitleSimilarityWeighted(a, b, corpus)
+		if weighted >= plain {
+			t.Errorf("TitleSimilarityWeighted = %f, want less than unweighted %f", weighted, plain)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		corpus   []string
+		expected float64
+	}{
+		{"identical titles", "Star Wars The Force Awakens", "star.wars.the.force.awakens", corpus, 1.0},
+		{"no shared words", "The Matrix", "Star Wars A New Hope", corpus, 0.0},
+		{"empty corpus is plain dice", "Matrix Reloaded", "Matrix Revolutions", nil, 0.5},
+		{"empty titles", "", "", corpus, 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TitleSimilarityWeighted(tt.a, tt.b, tt.corpus)
+			if math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("TitleSimilarityWeighted(%q, %q) = %f, want %f", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func BenchmarkCleanString(b *testing.B) {
+	names := []string{
+		"The.Matrix.1999.1080p.BluRay.x264-SPARKS",
+		"Game.of.Thrones.S08.COMPLETE.1080p.BluRay.x264-ROVERS[rartv]",
+		"[SubsPlease] Jujutsu Kaisen - 01 (1080p) [ABCD1234]",
+		"Some Movie (2010)",
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, name := range names {
+			cleanString(name)
+		}
+	}
+}
+
+func BenchmarkIsQualityTag(b *testing.B) {
+	tokens := []string{"SPARKS", "x264", "ROVERS", "BluRay", "RARBG", "repack"}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, token := range tokens {
+			isQualityTag(token)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package torrentname
+
+import (
+	"strings"
+	"time"
+)
+
+// DefaultMinYear is the earliest year accepted as a release year
+const DefaultMinYear = 1895
+
+// DefaultHDBitsBoost is the factor ParseWithHints scales HDBits confidence by
+const DefaultHDBitsBoost = 1.1
+
+// Weights holds the confidence score contributions of each parsed field
+type Weights struct {
+	YearSeason   int
+	Resolution   int
+	Source       int
+	ReleaseGroup int
+	MinorField   int
+}
+
+// DefaultWeights are the confidence weights used by Parse
+var DefaultWeights = Weights{
+	YearSeason:   YearSeasonWeight,
+	Resolution:   ResolutionWeight,
+	Source:       SourceWeight,
+	ReleaseGroup: ReleaseGroupWeight,
+	MinorField:   MinorFieldWeight,
+}
+
+// Parser parses torrent names with a fixed configuration.
+// A Parser is never modified after NewParser returns, so a single
+// instance is safe for concurrent use by multiple goroutines.
+type Parser struct {
+	minYear             int
+	maxYear             int // 0 means the current year
+	currentYear         int // 0 means the year at parse time
+	weights             Weights
+	groups              map[string]string // upper-cased group name -> canonical spelling
+	rawTitle            bool
+	numericEpisodeCodes bool
+	unparsed            bool
+	listIndex           bool
+	precedence          *QualityRanks // nil keeps the first resolution, source and codec found
+	hdbitsBoost         float64
+}
+
+// Option configures a Parser
+type Option func(*Parser)
+
+// defaultParser backs the package-level Parse function
+var defaultParser = NewParser()
+
+// NewParser returns a Parser configured by opts
+func NewParser(opts ...Option) *Parser {
+	p := &Parser{
+		minYear:     DefaultMinYear,
+		weights:     DefaultWeights,
+		hdbitsBoost: DefaultHDBitsBoost,
+		unparsed:    true,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithYearRange limits release years to [min, max]. A max of 0 tracks the current year.
+func WithYearRange(min, max int) Option {
+	return func(p *Parser) {
+		p.minYear = min
+		p.maxYear = max
+	}
+}
+
+// WithCurrentYear fixes the year the Parser treats as the current one, which
+// caps release years when WithYearRange leaves the maximum at 0. By default the
+// clock is read at parse time; a fixed year keeps results reproducible.
+func WithCurrentYear(year int) Option {
+	return func(p *Parser) {
+		p.currentYear = year
+	}
+}
+
+// WithQualityPrecedence settles repeated resolutions, sources and codecs by rank
+// instead of position: when a name carries several (1080p.720p.BluRay.WEB), the
+// one ranked highest by ranks wins, the others are reported in Ignored and the
+// scan goes on. By default the first one found is kept and the repeat ends the
+// scan, leaving what precedes it in the title.
+func WithQualityPrecedence(ranks QualityRanks) Option {
+	return func(p *Parser) {
+		p.precedence = &ranks
+	}
+}
+
+// WithWeights replaces the confidence scoring weights
+func WithWeights(w Weights) Option {
+	return func(p *Parser) {
+		p.weights = w
+	}
+}
+
+// WithReleaseGroups adds known release groups. A known group is recognized even
+// when it is the last dot- or space-separated token rather than a "-GROUP" suffix,
+// and is reported with the spelling given here.
+func WithReleaseGroups(groups ...string) Option {
+	return func(p *Parser) {
+		if p.groups == nil {
+			p.groups = make(map[string]string)
+		}
+		for _, group := range groups {
+			p.groups[strings.ToUpper(group)] = group
+		}
+	}
+}
+
+// WithHDBitsBoost sets the factor ParseWithHints scales the confidence of
+// HDBits names by; the result is rounded down and capped at 100. A factor of
+// 1 disables the boost.
+func WithHDBitsBoost(factor float64) Option {
+	return func(p *Parser) {
+		p.hdbitsBoost = factor
+	}
+}
+
+// WithRawTitle also reports the title as it appears in the name, separators
+// and all ("The.Matrix"), in TorrentInfo.RawTitle
+func WithRawTitle(enabled bool) Option {
+	return func(p *Parser) {
+		p.rawTitle = enabled
+	}
+}
+
+// WithNumericEpisodeCodes reads a bare three- or four-digit number right after
+// the title as a season and episode code: the last two digits are the episode
+// and the rest the season ("Friends.101" is S01E01, "1205" is S12E05). Off by
+// default since titles contain numbers too; numbers that are plausible release
+// years are never read as codes.
+func WithNumericEpisodeCodes(enabled bool) Option {
+	return func(p *Parser) {
+		p.numericEpisodeCodes = enabled
+	}
+}
+
+// WithUnparsed controls whether TorrentInfo.Unparsed is filled. On by default.
+// The leftover words are read either way, since they weigh on the title's
+// FieldConfidence, so turning it off changes no other field.
+func WithUnparsed(enabled bool) Option {
+	return func(p *Parser) {
+		p.unparsed = enabled
+	}
+}
+
+// WithListIndex controls whether a leading list position ("[01] The.Matrix",
+// "01. The.Matrix") is stripped from the title into TorrentInfo.ListIndex. Off
+// by default since titles can start with numbers too; only square-bracketed and
+// zero-padded numbers count, so "10.Things...", "21.Jump.Street" and
+// "(500).Days.of.Summer" keep their titles either way.
+func WithListIndex(enabled bool) Option {
+	return func(p *Parser) {
+		p.listIndex = enabled
+	}
+}
+
+// isReleaseYear reports whether year falls in the Parser's release year window
+func (p *Parser) isReleaseYear(year int) bool {
+	return year >= p.minYear && year <= p.lastYear()
+}
+
+// lastYear returns the latest release year: the configured maximum, or the current year
+func (p *Parser) lastYear() int {
+	if p.maxYear != 0 {
+		return p.maxYear
+	}
+	return p.thisYear()
+}
+
+// thisYear returns the configured current year, or the clock's
+func (p *Parser) thisYear() int {
+	if p.currentYear != 0 {
+		return p.currentYear
+	}
+	return time.Now().Year()
+}
+
+// isReleaseGroup reports whether a token introduced by a hyphen can be a
+// release group. Quality tags, single characters and bare numbers (-720, a
+// mangled resolution or codec) are rejected unless configured as known groups.
+func (p *Parser) isReleaseGroup(group string) bool {
+	if _, ok := p.groups[strings.ToUpper(group)]; ok {
+		return true
+	}
+	return len(group) >= 2 && !isQualityTag(group) && strings.Trim(group, "0123456789") != ""
+}
+
+// stripKnownGroup removes a trailing known release group that isn't introduced by
+// a hyphen, returning the remaining name and the group's canonical spelling
+func (p *Parser) stripKnownGroup(name string) (string, string) {
+	if len(p.groups) == 0 {
+		return name, ""
+	}
+
+	cut := strings.LastIndexAny(name, ". ")
+	if cut <= 0 {
+		return name, ""
+	}
+
+	group, ok := p.groups[strings.ToUpper(name[cut+1:])]
+	if !ok {
+		return name, ""
+	}
+	return name[:cut], group
+}
+
+// stripCollabGroup removes a trailing group written with a hyphen of its own
+// (x264-DON-WiKi, BluRay-D-Z0N3), returning the remaining name and the whole
+// group. It only applies right after a quality token, so a hyphenated quality
+// (WEB-DL-GROUP, DTS-HD) is never taken for part of the group. Reposter
+// suffixes (-postbot, -Obfuscated) are dropped before this runs, so
+// NTb-postbot gives NTb rather than a joint group.
+func (p *Parser) stripCollabGroup(name string) (string, string) {
+	m := collabGroupPattern.FindStringSubmatchIndex(name)
+	if m == nil {
+		return name, ""
+	}
+	first, last := name[m[2]:m[3]], name[m[4]:m[5]]
+	if isQualityTag(first) || !p.isReleaseGroup(last) {
+		return name, ""
+	}
+	anchored := false
+	for _, pat := range collabAnchors {
+		for _, loc := range pat.FindAllStringIndex(name, -1) {
+			if loc[0] < m[0] && loc[1] > m[0] {
+				// The first part ends a quality token (DTS-X-GROUP)
+				return name, ""
+			}
+			anchored = anchored || loc[1] == m[0]
+		}
+	}
+	if !anchored {
+		return name, ""
+	}
+	return name[:m[0]], first + "-" + last
+}
@@ -0,0 +1,314 @@
+package torrentname
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestNewParserDefaults(t *testing.T) {
+	p := NewParser()
+	for _, input := range []string{
+		"The.Matrix.1999.1080p.BluRay.x264-SPARKS",
+		"Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS",
+		"Some Movie",
+	} {
+		compareTorrentInfo(t, p.Parse(input), Parse(input))
+	}
+}
+
+func TestParserWithYearRange(t *testing.T) {
+	p := NewParser(WithYearRange(1950, 2000))
+
+	result := p.Parse("Classic.Movie.1940.1080p.BluRay.x264-GROUP")
+	if result.Year != 0 {
+		t.Errorf("Year: got %d, want 0 (outside window)", result.Year)
+	}
+	if result.Title != "Classic Movie 1940" {
+		t.Errorf("Title: got %q, want %q", result.Title, "Classic Movie 1940")
+	}
+
+	result = p.Parse("The.Matrix.1999.1080p.BluRay.x264-SPARKS")
+	if result.Year != 1999 {
+		t.Errorf("Year: got %d, want 1999", result.Year)
+	}
+}
+
+func TestParserWithCurrentYear(t *testing.T) {
+	tests := []struct {
+		current int
+		input   string
+		year    int
+		title   string
+	}{
+		{2030, "Future.Movie.2029.1080p.BluRay.x264-GROUP", 2029, "Future Movie"},
+		{2000, "Future.Movie.2010.1080p.BluRay.x264-GROUP", 0, "Future Movie 2010"},
+		{2000, "The.Matrix.1999.1080p.BluRay.x264-SPARKS", 1999, "The Matrix"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := NewParser(WithCurrentYear(tt.current)).Parse(tt.input)
+			if result.Year != tt.year {
+				t.Errorf("Year: got %d, want %d", result.Year, tt.year)
+			}
+			if result.Title != tt.title {
+				t.Errorf("Title: got %q, want %q", result.Title, tt.title)
+			}
+		})
+	}
+
+	// An explicit maximum takes precedence over the current year
+	p := NewParser(WithCurrentYear(2000), WithYearRange(DefaultMinYear, 2010))
+	if year := p.Parse("Future.Movie.2010.1080p.BluRay.x264-GROUP").Year; year != 2010 {
+		t.Errorf("Year with explicit maximum: got %d, want 2010", year)
+	}
+}
+
+func TestParserWithQualityPrecedence(t *testing.T) {
+	p := NewParser(WithQualityPrecedence(DefaultQualityRanks))
+
+	tests := []struct {
+		input      string
+		resolution string
+		source     string
+		ignored    []string
+	}{
+		{"Some.Movie.2020.1080p.720p.BluRay.WEB.x264.H265-GROUP", "1080p", "BluRay", []string{"720p", "WEB", "x264"}},
+		{"Some.Movie.2020.720p.2160p.HDTV.WEB-DL-GROUP", "2160p", "WEB-DL", []string{"720p", "HDTV"}},
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", "1080p", "BluRay", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := p.Parse(tt.input)
+			if result.Resolution != tt.resolution {
+				t.Errorf("Resolution: got %q, want %q", result.Resolution, tt.resolution)
+			}
+			if result.Source != tt.source {
+				t.Errorf("Source: got %q, want %q", result.Source, tt.source)
+			}
+			if !reflect.DeepEqual(result.Ignored, tt.ignored) {
+				t.Errorf("Ignored: got %q, want %q", result.Ignored, tt.ignored)
+			}
+		})
+	}
+
+	// The scan goes on past the repeats, so the title ends where it should
+	if title := p.Parse("Some.Movie.2020.1080p.720p.BluRay.WEB.x264.H265-GROUP").Title; title != "Some Movie" {
+		t.Errorf("Title: got %q, want %q", title, "Some Movie")
+	}
+
+	// By default the first repeat ends the scan
+	if source := Parse("Some.Movie.2020.1080p.720p.BluRay.WEB.x264.H265-GROUP").Source; source != "" {
+		t.Errorf("default Source: got %q, want empty", source)
+	}
+}
+
+func TestParserWithWeights(t *testing.T) {
+	p := NewParser(WithWeights(Weights{YearSeason: 50, Resolution: 25, Source: 5, ReleaseGroup: 5, MinorField: 2}))
+
+	result := p.Parse("The.Matrix.1999.1080p.BluRay.x264-SPARKS")
+	if want := 50 + 25 + 5 + 5 + 2; result.Confidence != want {
+		t.Errorf("Confidence: got %d, want %d", result.Confidence, want)
+	}
+}
+
+func TestParserWithReleaseGroups(t *testing.T) {
+	p := NewParser(WithReleaseGroups("SPARKS", "ESiR", "1337"))
+
+	tests := []struct {
+		name     string
+		input    string
+		title    string
+		group    string
+		unparsed string
+	}{
+		{"group without hyphen", "The.Matrix.1999.1080p.BluRay.x264.SPARKS", "The Matrix", "SPARKS", ""},
+		{"canonical spelling", "The.Dark.Knight.2008.1080p.BluRay.x264-esir", "The Dark Knight", "ESiR", ""},
+		{"unknown trailing token", "The.Matrix.1999.1080p.BluRay.x264.OTHER", "The Matrix", "", "OTHER"},
+		{"numeric known group", "The.Matrix.1999.1080p.BluRay.x264-1337", "The Matrix", "1337", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := p.Parse(tt.input)
+			if result.Title != tt.title {
+				t.Errorf("Title: got %q, want %q", result.Title, tt.title)
+			}
+			if result.ReleaseGroup != tt.group {
+				t.Errorf("ReleaseGroup: got %q, want %q", result.ReleaseGroup, tt.group)
+			}
+			if result.Unparsed != tt.unparsed {
+				t.Errorf("Unparsed: got %q, want %q", result.Unparsed, tt.unparsed)
+			}
+		})
+	}
+}
+
+func TestParserConcurrentUse(t *testing.T) {
+	p := NewParser(WithReleaseGroups("SPARKS"))
+	want := p.Parse("The.Matrix.1999.1080p.BluRay.x264.SPARKS")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				compareTorrentInfo(t, p.Parse("The.Matrix.1999.1080p.BluRay.x264.SPARKS"), want)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestParserWithRawTitle(t *testing.T) {
+	p := NewParser(WithRawTitle(true))
+
+	tests := []struct {
+		input string
+		title string
+		raw   string
+	}{
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", "The Matrix", "The.Matrix"},
+		{"The_Office_S01E01_720p_HDTV-GROUP", "The Office", "The_Office"},
+		{"1080p.WEB-DL.The.Office.S01E01-GROUP", "The Office", "The.Office"},
+		{"Some Movie", "Some Movie", "Some Movie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := p.Parse(tt.input)
+			if result.Title != tt.title {
+				t.Errorf("Title: got %q, want %q", result.Title, tt.title)
+			}
+			if result.RawTitle != tt.raw {
+				t.Errorf("RawTitle: got %q, want %q", result.RawTitle, tt.raw)
+			}
+		})
+	}
+
+	if raw := Parse("The.Matrix.1999.1080p.BluRay.x264-SPARKS").RawTitle; raw != "" {
+		t.Errorf("RawTitle without option: got %q, want empty", raw)
+	}
+}
+
+func TestParserWithUnparsed(t *testing.T) {
+	p := NewParser(WithUnparsed(false))
+
+	for _, input := range []string{
+		"Breaking.Bad.S01E01.Pilot.1080p.BluRay.x264-ROVERS",
+		"Movie.2019.1080p.BluRay.x264.Extra.Words-GROUP",
+		"Show.S01E05.4K.Restoration.1080p.WEB-DL-GROUP",
+		"Movie.2020.1080p.Foo.Bar.BluRay-GRP",
+	} {
+		t.Run(input, func(t *testing.T) {
+			want := Parse(input)
+			got := p.Parse(input)
+			want.Unparsed = ""
+			compareTorrentInfo(t, got, want)
+			if !reflect.DeepEqual(got.FieldConfidence, want.FieldConfidence) {
+				t.Errorf("FieldConfidence: got %v, want %v", got.FieldConfidence, want.FieldConfidence)
+			}
+		})
+	}
+}
+
+func TestParserWithListIndex(t *testing.T) {
+	p := NewParser(WithListIndex(true))
+
+	tests := []struct {
+		input     string
+		title     string
+		listIndex int
+	}{
+		{"01. The.Matrix.1999.1080p-GROUP", "The Matrix", 1},
+		{"[12] The.Matrix.1999.1080p-GROUP", "The Matrix", 12},
+		{"10.Things.I.Hate.About.You.1999.1080p-GROUP", "10 Things I Hate About You", 0},
+		{"(500).Days.of.Summer.2009.1080p.BluRay.x264-GROUP", "(500) Days of Summer", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := p.Parse(tt.input)
+			if result.Title != tt.title || result.ListIndex != tt.listIndex {
+				t.Errorf("got Title %q ListIndex %d, want %q and %d", result.Title, result.ListIndex, tt.title, tt.listIndex)
+			}
+		})
+	}
+
+	if result := Parse("01. The.Matrix.1999.1080p-GROUP"); result.Title != "01 The Matrix" || result.ListIndex != 0 {
+		t.Errorf("without option: got Title %q ListIndex %d, want %q and 0", result.Title, result.ListIndex, "01 The Matrix")
+	}
+	if result := Parse("(500).Days.of.Summer.2009.1080p.BluRay.x264-GROUP"); result.Title != "(500) Days of Summer" || result.ListIndex != 0 {
+		t.Errorf("without option: got Title %q ListIndex %d, want %q and 0", result.Title, result.ListIndex, "(500) Days of Summer")
+	}
+}
+
+func TestParserWithNumericEpisodeCodes(t *testing.T) {
+	p := NewParser(WithNumericEpisodeCodes(true))
+
+	tests := []struct {
+		input   string
+		title   string
+		year    int
+		season  int
+		episode int
+	}{
+		{"Friends.101.720p.HDTV-GROUP", "Friends", 0, 1, 1},
+		{"Friends.1205.720p.HDTV-GROUP", "Friends", 0, 12, 5},
+		{"Friends.1999.720p.HDTV-GROUP", "Friends", 1999, 0, 0},
+		{"Room.237.2012.1080p.BluRay-GROUP", "Room 237", 2012, 0, 0},
+		{"101.Dalmatians.1996.1080p.BluRay-GROUP", "101 Dalmatians", 1996, 0, 0},
+		{"Friends.100.720p.HDTV-GROUP", "Friends 100", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := p.Parse(tt.input)
+			if result.Title != tt.title {
+				t.Errorf("Title: got %q, want %q", result.Title, tt.title)
+			}
+			if result.Year != tt.year {
+				t.Errorf("Year: got %d, want %d", result.Year, tt.year)
+			}
+			if result.Season != tt.season || result.Episode != tt.episode {
+				t.Errorf("Season/Episode: got %d/%d, want %d/%d", result.Season, result.Episode, tt.season, tt.episode)
+			}
+			if result.Unparsed != "" || result.EpisodeTitle != "" {
+				t.Errorf("Unparsed/EpisodeTitle: got %q/%q, want empty", result.Unparsed, result.EpisodeTitle)
+			}
+		})
+	}
+
+	if result := Parse("Friends.101.720p.HDTV-GROUP"); result.Season != 0 || result.Title != "Friends 101" {
+		t.Errorf("without option: got Title %q Season %d, want %q and 0", result.Title, result.Season, "Friends 101")
+	}
+}
+
+func TestParserWithHDBitsBoost(t *testing.T) {
+	name := "The.Dark.Knight.2008.1080p.BluRay.DTS.x264-ESiR"
+	base := Parse(name).Confidence
+
+	if result := ParseWithHints(name, "HDBits"); result.Confidence != boostConfidence(base, DefaultHDBitsBoost) {
+		t.Errorf("default boost: got %d, want %d", result.Confidence, boostConfidence(base, DefaultHDBitsBoost))
+	}
+
+	p := NewParser(WithHDBitsBoost(1))
+	if result := p.ParseWithHints(name, "hdb"); result.Confidence != base {
+		t.Errorf("boost disabled: got %d, want %d", result.Confidence, base)
+	}
+}
+
+func BenchmarkParseWithoutUnparsed(b *testing.B) {
+	p := NewParser(WithUnparsed(false))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, name := range benchmarkNames {
+			p.Parse(name)
+		}
+	}
+}
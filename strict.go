@@ -0,0 +1,53 @@
+package torrentname
+
+import "fmt"
+
+// Reasons a ParseError gives for rejecting a parse
+const (
+	ReasonNoTitle       = "no_title"
+	ReasonLowConfidence = "low_confidence"
+)
+
+// Sentinel errors for the common rejection reasons. A ParseError matches the
+// sentinel with the same Reason under errors.Is, whatever its Confidence.
+var (
+	ErrNoTitle       = &ParseError{Reason: ReasonNoTitle}
+	ErrLowConfidence = &ParseError{Reason: ReasonLowConfidence}
+)
+
+// ParseError reports why ParseStrict rejected a parse
+type ParseError struct {
+	Reason     string // ReasonNoTitle or ReasonLowConfidence
+	Confidence int    // Confidence of the rejected parse
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("torrentname: %s (confidence %d)", e.Reason, e.Confidence)
+}
+
+// Is reports whether target is a ParseError with the same Reason
+func (e *ParseError) Is(target error) bool {
+	t, ok := target.(*ParseError)
+	return ok && t.Reason == e.Reason
+}
+
+// ParseStrict parses name with the default Parser and rejects doubtful
+// results. See Parser.ParseStrict.
+func ParseStrict(name string, minConfidence int) (*TorrentInfo, error) {
+	return defaultParser.ParseStrict(name, minConfidence)
+}
+
+// ParseStrict parses name and returns a *ParseError when no title was found
+// (ReasonNoTitle) or the confidence is below minConfidence
+// (ReasonLowConfidence). The parse result is returned either way so callers
+// can log or inspect what was rejected.
+func (p *Parser) ParseStrict(name string, minConfidence int) (*TorrentInfo, error) {
+	info := p.Parse(name)
+	if info.Title == "" {
+		return info, &ParseError{Reason: ReasonNoTitle, Confidence: info.Confidence}
+	}
+	if info.Confidence < minConfidence {
+		return info, &ParseError{Reason: ReasonLowConfidence, Confidence: info.Confidence}
+	}
+	return info, nil
+}
@@ -0,0 +1,75 @@
+package torrentname
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		min        int
+		reason     string
+		confidence int
+	}{
+		{
+			name:  "accepted",
+			input: "The.Matrix.1999.1080p.BluRay.x264-SPARKS",
+			min:   50,
+		},
+		{
+			name:       "low confidence",
+			input:      "Some.Home.Video",
+			min:        50,
+			reason:     ReasonLowConfidence,
+			confidence: 0,
+		},
+		{
+			name:       "no title",
+			input:      "1080p.BluRay.x264",
+			min:        0,
+			reason:     ReasonNoTitle,
+			confidence: ResolutionWeight + SourceWeight + MinorFieldWeight,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := ParseStrict(tt.input, tt.min)
+			if info == nil {
+				t.Fatal("ParseStrict returned a nil result")
+			}
+			if tt.reason == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("error %v is not a *ParseError", err)
+			}
+			if perr.Reason != tt.reason {
+				t.Errorf("Reason: got %q, want %q", perr.Reason, tt.reason)
+			}
+			if perr.Confidence != tt.confidence {
+				t.Errorf("Confidence: got %d, want %d", perr.Confidence, tt.confidence)
+			}
+		})
+	}
+}
+
+func TestParseErrorIs(t *testing.T) {
+	err := error(&ParseError{Reason: ReasonLowConfidence, Confidence: 12})
+	if !errors.Is(err, ErrLowConfidence) {
+		t.Error("errors.Is(err, ErrLowConfidence) = false, want true")
+	}
+	if errors.Is(err, ErrNoTitle) {
+		t.Error("errors.Is(err, ErrNoTitle) = true, want false")
+	}
+	if got, want := err.Error(), "torrentname: low_confidence (confidence 12)"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
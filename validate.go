@@ -0,0 +1,54 @@
+package torrentname
+
+import "fmt"
+
+// suspiciousConfidence is the confidence at which an empty title stops looking
+// like an unparseable name and starts looking like a parser mistake
+const suspiciousConfidence = 50
+
+// Validate checks a parse result for combinations of fields that usually mean
+// the name was misparsed and returns one human-readable warning per problem,
+// or nil when nothing looks wrong. It never modifies info. Years are checked
+// against the default Parser's release years.
+func (info *TorrentInfo) Validate() []string {
+	return defaultParser.Validate(info)
+}
+
+// Validate is TorrentInfo.Validate with years checked against the Parser's
+// release years, as set by WithYearRange and WithCurrentYear
+func (p *Parser) Validate(info *TorrentInfo) []string {
+	if info == nil {
+		return nil
+	}
+
+	var warnings []string
+	warn := func(format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+
+	if info.Title == "" && info.Confidence >= suspiciousConfidence {
+		warn("confidence %d but title is empty", info.Confidence)
+	}
+	if info.Title != "" && isQualityTag(info.Title) {
+		warn("title %q is a quality tag", info.Title)
+	}
+	if info.Year > p.lastYear() {
+		warn("year %d is in the future", info.Year)
+	} else if info.Year != 0 && info.Year < p.minYear {
+		warn("year %d is before %d", info.Year, p.minYear)
+	}
+	if info.Episode != 0 && !info.HasSeason {
+		warn("episode %d has no season", info.Episode)
+	}
+	if info.SeasonEnd != 0 && info.SeasonEnd < info.Season {
+		warn("season range S%02d-S%02d ends before it starts", info.Season, info.SeasonEnd)
+	}
+	if info.EpisodeEnd != 0 && info.EpisodeEnd < info.Episode {
+		warn("episode range E%02d-E%02d ends before it starts", info.Episode, info.EpisodeEnd)
+	}
+	if info.IsSeasonPack && info.Episode != 0 {
+		warn("season pack has episode %d", info.Episode)
+	}
+
+	return warnings
+}
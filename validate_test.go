@@ -0,0 +1,95 @@
+package torrentname
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	future := time.Now().Year() + 5
+
+	tests := []struct {
+		name     string
+		info     *TorrentInfo
+		expected []string
+	}{
+		{
+			name:     "clean parse",
+			info:     Parse("Breaking.Bad.S01E01.720p.BluRay.x264-DEMAND"),
+			expected: nil,
+		},
+		{
+			name:     "nil info",
+			info:     nil,
+			expected: nil,
+		},
+		{
+			name:     "episode without season",
+			info:     &TorrentInfo{Title: "Show", Episode: 5},
+			expected: []string{"episode 5 has no season"},
+		},
+		{
+			name:     "S00 special is not flagged",
+			info:     &TorrentInfo{Title: "Show", HasSeason: true, Episode: 5},
+			expected: nil,
+		},
+		{
+			name:     "future year",
+			info:     &TorrentInfo{Title: "Movie", Year: future},
+			expected: []string{"year " + strconv.Itoa(future) + " is in the future"},
+		},
+		{
+			name:     "title is a quality tag",
+			info:     &TorrentInfo{Title: "1080p"},
+			expected: []string{`title "1080p" is a quality tag`},
+		},
+		{
+			name:     "high confidence without title",
+			info:     &TorrentInfo{Year: 1999, Resolution: "1080p", Confidence: YearSeasonWeight + ResolutionWeight},
+			expected: []string{"confidence 60 but title is empty"},
+		},
+		{
+			name: "reversed ranges",
+			info: &TorrentInfo{Title: "Show", Season: 3, HasSeason: true, SeasonEnd: 1, Episode: 4, EpisodeEnd: 2},
+			expected: []string{
+				"season range S03-S01 ends before it starts",
+				"episode range E04-E02 ends before it starts",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.info.Validate()
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Validate() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParserValidateYears(t *testing.T) {
+	tests := []struct {
+		name     string
+		parser   *Parser
+		year     int
+		expected []string
+	}{
+		{"after the current year", NewParser(WithCurrentYear(2020)), 2021, []string{"year 2021 is in the future"}},
+		{"in the current year", NewParser(WithCurrentYear(2020)), 2020, nil},
+		{"within a configured range", NewParser(WithCurrentYear(2020), WithYearRange(1950, 2030)), 2025, nil},
+		{"after a configured range", NewParser(WithYearRange(1950, 2030)), 2031, []string{"year 2031 is in the future"}},
+		{"before a configured range", NewParser(WithYearRange(1950, 2030)), 1949, []string{"year 1949 is before 1950"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.parser.Validate(&TorrentInfo{Title: "Movie", Year: tt.year})
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Validate() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}